@@ -41,6 +41,166 @@ const docTemplate = `{
                 }
             }
         },
+        "/import-type-categories": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the managed category list, ordered by name. The category of an import type has to be one of these ids.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "List import type categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeCategory"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-type-categories/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates or updates a category. Only admins may manage categories.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Set import type category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category; the id has to match the path",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeCategory"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeCategory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Removes a category, which is not used by any import type. Only admins may manage categories.",
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete import type category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/import-types": {
             "get": {
                 "security": [
@@ -48,7 +208,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Returns import types visible to the caller. If ` + "`" + `ids` + "`" + ` is provided, pagination is ignored.",
+                "description": "Returns import types visible to the caller. ` + "`" + `ids` + "`" + ` filters the result, which is still paginated.",
                 "produces": [
                     "application/json"
                 ],
@@ -67,10 +227,23 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 0,
-                        "description": "Result offset",
+                        "description": "Result offset; ignored if cursor is set",
                         "name": "offset",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from the X-Next-Cursor header of the previous page; requires the same sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Count the total number of matching import types",
+                        "name": "with_total",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Comma-separated import type ids",
@@ -85,16 +258,57 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Free-text search term",
+                        "description": "Comma-separated tags; only import types with all tags are listed",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category ids; only import types with one of the categories are listed",
+                        "name": "categories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term",
                         "name": "search",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "text",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "text: weighted word search in name, description, config names and descriptions and output variable names; prefix: case-insensitive match anywhere in the name",
+                        "name": "search_mode",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "default": "name.asc",
-                        "description": "Sort order",
+                        "description": "Sort order as field.asc or field.desc, or relevance for text searches",
                         "name": "sort",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include the etag of each import type in the result",
+                        "name": "with_etag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return model.ImportTypeExtended instead of model.ImportType",
+                        "name": "extended",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return model.ImportTypeExtended with the device-repository metadata of used aspects, functions and characteristics",
+                        "name": "resolve_references",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -107,9 +321,13 @@ const docTemplate = `{
                             }
                         },
                         "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page; missing on the last page or if the sort does not support cursors (supported: id, name, description, image, owner, cost)"
+                            },
                             "X-Total-Count": {
                                 "type": "integer",
-                                "description": "Total number of matching import types"
+                                "description": "Total number of matching import types; missing if with_total=false"
                             }
                         }
                     },
@@ -166,12 +384,18 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the import type"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid import type (json findings) or other bad request (plain text)",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/model.ValidationError"
                         }
                     },
                     "403": {
@@ -189,53 +413,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/import-types/{id}": {
-            "get": {
+        "/import-types/bulk": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Returns a single import type by id.",
+                "description": "Executes a list of operations and returns a result (id, code, error) per operation in the same order.\ncreate expects import_type; update expects import_type with id and optionally etag; delete expects id and optionally etag.\nAll operations are checked before anything is written. Without atomic, every valid operation is executed on its own and the response code is 200.\nWith atomic=true, all changes are written in one transaction, which is limited to 100 operations. If any operation fails, nothing is changed, the other operations report 424 and the response code is the code of the failed operation.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "import-types"
                 ],
-                "summary": "Get import type",
+                "summary": "Bulk create, update and delete import types",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Import type id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Execute all operations or none",
+                        "name": "atomic",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Operations",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkOperation"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ImportType"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkResult"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkResult"
+                            }
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkResult"
+                            }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkResult"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeBulkResult"
+                            }
                         }
                     },
                     "500": {
@@ -245,50 +504,322 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/import-types/facets": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Replaces an import type. The request body id must match the path id.",
-                "consumes": [
+                "description": "Counts the import types matching the filters, which are visible to the caller, by function, aspect, characteristic, owner and cost bucket.\nEvery import type is counted at most once per value.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "import-types"
                 ],
-                "summary": "Update import type",
+                "summary": "Count import types by facet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Import type id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma-separated import type ids",
+                        "name": "ids",
+                        "in": "query"
                     },
                     {
-                        "description": "Full import type payload",
-                        "name": "importType",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.ImportType"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "JSON-encoded filter criteria array",
+                        "name": "criteria",
+                        "in": "query"
                     },
-                    "403": {
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags; only import types with all tags are counted",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category ids; only import types with one of the categories are counted",
+                        "name": "categories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "text: weighted word search in name, description, config names and descriptions and output variable names; prefix: case-insensitive match anywhere in the name",
+                        "name": "search_mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeFacets"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/tags": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the tags used by import types matching the filters, which are visible to the caller, with the number of import types per tag; most used first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "List import type tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated import type ids",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON-encoded filter criteria array",
+                        "name": "criteria",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tags; only import types with all tags are counted",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated category ids; only import types with one of the categories are counted",
+                        "name": "categories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "text: weighted word search in name, description, config names and descriptions and output variable names; prefix: case-insensitive match anywhere in the name",
+                        "name": "search_mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeTagCount"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns a single import type by id. The current version is returned in the ETag header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Get import type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etag of a known version; responds with 304 if unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return model.ImportTypeExtended instead of model.ImportType; no ETag is returned for the extended form",
+                        "name": "extended",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return model.ImportTypeExtended with the device-repository metadata of used aspects, functions and characteristics",
+                        "name": "resolve_references",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the import type"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replaces an import type. The request body id must match the path id.\nIf an etag is provided by the If-Match header or the etag field of the body, the update is only applied if it matches the stored version.\nUpdates with breaking changes (e.g. removed output sub content variables or configs, changed types) are rejected with 409, unless force=true is set or the update adds a release with a higher major version (minor version for 0.x).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Update import type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etag of the expected version",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply breaking changes",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Full import type payload",
+                        "name": "importType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import type (json findings) or other bad request (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationError"
+                        }
+                    },
+                    "403": {
                         "description": "Forbidden",
                         "schema": {
                             "type": "string"
@@ -300,6 +831,18 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.BreakingChangesError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -326,6 +869,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etag of the expected version",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -350,6 +899,12 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -357,86 +912,2146 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Partially updates an import type. Accepts merge patches (RFC 7396, Content-Type application/merge-patch+json or application/json) and json patches (RFC 6902, Content-Type application/json-patch+json).\nThe patched import type is validated and checked like a full update. Id and owner may not be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Patch import type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etag of the expected version",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply breaking changes",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Merge patch or json patch document",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import type (json findings) or other bad request (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.BreakingChangesError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/check-payload": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Validates a message against the output of an import type: types, required sub content variables, list elements and values of tag fields.\nFindings are returned with status 200; their path is a json pointer into the message.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "payload"
+                ],
+                "summary": "Check payload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/configs:validate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Validates config values, e.g. of a planned deployment, against the configs of an import type: unknown configs, missing required values without default, types, enums, ranges and patterns.\nFindings are returned with status 200; their path is a json pointer to the value.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Validate config values",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Config values by config name",
+                        "name": "values",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the classified changes from a revision to the current version of an import type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "revisions"
+                ],
+                "summary": "Diff import type revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "against",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the classified changes an update to the import type in the request body would apply; breaking changes would be rejected by an update without force=true.\nNothing is stored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Diff import type update",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proposed import type",
+                        "name": "importType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the user, group and role permissions of an import type. Requires the administrate permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "permissions"
+                ],
+                "summary": "Get import type permissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replaces the user, group and role permissions of an import type. Requires the administrate permission.\nAt least one user has to keep the administrate permission. The admin role always keeps full access.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "permissions"
+                ],
+                "summary": "Set import type permissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Permissions",
+                        "name": "permissions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourcePermissions"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/releases": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the releases of an import type, newest version first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "releases"
+                ],
+                "summary": "List import type releases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "stable",
+                            "beta",
+                            "deprecated"
+                        ],
+                        "type": "string",
+                        "description": "Only return releases of this channel",
+                        "name": "channel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeRelease"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Appends a release to the import type. The version has to be a semantic version higher than all existing versions and the image a valid image reference.\nThe channel defaults to stable; the image of the import type is set to the image of the latest stable release.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "releases"
+                ],
+                "summary": "Publish import type release",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Release; published_at is set by the server",
+                        "name": "release",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeRelease"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid release (json findings) or other bad request (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Moves a deleted import type back from the trash and restores the permissions it had when it was deleted.\nRequires the administrate permission at the time of deletion. The restore is recorded as a new revision.\nThe import type is validated like an update and compared with its latest revision; restores with breaking changes are rejected with 409, unless force=true is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "trash"
+                ],
+                "summary": "Restore deleted import type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply breaking changes",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import type (json findings) or other bad request (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Breaking changes (json) or import type already exists (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.BreakingChangesError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the stored revisions of an import type, newest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "List import type revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Result offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ImportTypeRevision"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of revisions"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/revisions/{rev}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns a single revision of an import type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Get import type revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeRevision"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/revisions/{rev}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replaces the import type with the snapshot of the given revision. The restore is recorded as a new revision.\nThe snapshot is checked like an update; restores with breaking changes are rejected with 409, unless force=true is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Restore import type revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply breaking changes",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import type (json findings) or other bad request (plain text)",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.BreakingChangesError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/sample": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Generates a synthetic example message from the output of an import type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "payload"
+                ],
+                "summary": "Get sample payload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/schema/avro": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns an avro schema of the messages produced by the import type. Structures and lists of fixed length are records, lists with a single \"*\" element are arrays.\nInvalid characters in names are replaced by '_', duplicates get a numeric suffix; the original name is kept as source_name.\nCharacteristic, function and aspect ids and use_as_tag are kept as custom field attributes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "schema"
+                ],
+                "summary": "Get import type avro schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "record, or an array or primitive type for outputs which are not structures",
+                        "schema": {
+                            "$ref": "#/definitions/model.AvroRecord"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/schema/compatibility": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Compares a proposed output with the current output of the import type. The proposed output is compatible, if its avro and protobuf schemas are able to read messages written with the current output (backward compatibility):\nsub content variables may be removed from the end, but not added (no avro default values) or moved (protobuf field numbers); types may not change; characteristics and use_as_tag may not change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "schema"
+                ],
+                "summary": "Check output compatibility",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proposed output",
+                        "name": "output",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ContentVariable"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OutputCompatibility"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/schema/configs": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns a JSON Schema (draft 2020-12) of the config values accepted by the import type, keyed by config name.\nDefault values of secret configs are never included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "schema"
+                ],
+                "summary": "Get import type configs schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.JsonSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/schema/output": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns a JSON Schema (draft 2020-12) of the messages produced by the import type.\nCharacteristic, function and aspect ids are annotated as x-characteristic-id, x-function-id and x-aspect-id.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "schema"
+                ],
+                "summary": "Get import type output schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.JsonSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/schema/proto": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns a proto3 definition of the messages produced by the import type. Messages are named like the avro records, field numbers follow the order of the sub content variables.\nCharacteristic, function and aspect ids and use_as_tag are kept as custom field options.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "import-types",
+                    "schema"
+                ],
+                "summary": "Get import type protobuf schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sets a new owner and moves the permissions of the previous owner to the new owner. Only admins and the current owner may transfer an import type.\nIf the permissions can not be updated, the owner change is reverted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "permissions"
+                ],
+                "summary": "Transfer import type ownership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import type id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportTypeOwnershipTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the import type"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/import-types:validate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Validates an import type, including the references to characteristics, functions and aspects at the device-repository, without storing it.\nValidation happens independent of the validate config flag. Findings are returned with status 200; findings with the code reference_check_failed mean that the device-repository could not be asked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types"
+                ],
+                "summary": "Validate import type draft",
+                "parameters": [
+                    {
+                        "description": "Import type draft",
+                        "name": "importType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ImportType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ValidationResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/import-types": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns deleted import types which have not been purged yet, most recently deleted first.\nAdmins see all entries, other users the entries they were allowed to administrate.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import-types",
+                    "trash"
+                ],
+                "summary": "List deleted import types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Result offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TrashedImportType"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of matching entries"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "model.AvroField": {
+            "type": "object",
+            "properties": {
+                "aspect_id": {
+                    "type": "string"
+                },
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source_name": {
+                    "description": "content variable name, if it is not a valid avro name",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "primitive type name, AvroRecord or AvroArray"
+                },
+                "use_as_tag": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.AvroRecord": {
+            "type": "object",
+            "properties": {
+                "aspect_id": {
+                    "type": "string"
+                },
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "doc": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AvroField"
+                    }
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source_name": {
+                    "description": "content variable name, if it is not a valid avro name",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "always \"record\"",
+                    "type": "string"
+                },
+                "use_as_tag": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.BreakingChangesError": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportTypeChange"
+                    }
+                }
+            }
+        },
+        "model.BulkOperationType": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "BulkCreate",
+                "BulkUpdate",
+                "BulkDelete"
+            ]
+        },
+        "model.ChangeKind": {
+            "type": "string",
+            "enum": [
+                "added",
+                "removed",
+                "modified"
+            ],
+            "x-enum-varnames": [
+                "ChangeAdded",
+                "ChangeRemoved",
+                "ChangeModified"
+            ]
+        },
+        "model.ContentVariable": {
+            "type": "object",
+            "properties": {
+                "aspect_id": {
+                    "type": "string"
+                },
+                "characteristic_id": {
+                    "type": "string"
+                },
+                "function_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sub_content_variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ContentVariable"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/model.Type"
+                },
+                "use_as_tag": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CostBucketCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "max": {
+                    "description": "exclusive; nil for the last bucket",
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.FacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImportConfig": {
+            "type": "object",
+            "properties": {
+                "default_value": {
+                    "description": "never returned to users other than the owner, if Secret is set"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enum": {
+                    "description": "allowed values; only for strings, integers and floats",
+                    "type": "array",
+                    "items": {}
+                },
+                "maximum": {
+                    "description": "inclusive; only for integers and floats",
+                    "type": "number"
+                },
+                "minimum": {
+                    "description": "inclusive; only for integers and floats",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pattern": {
+                    "description": "regular expression (RE2 syntax) a string has to match",
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.Type"
+                }
+            }
+        },
+        "model.ImportType": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "id of a model.ImportTypeCategory",
+                    "type": "string"
+                },
+                "configs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportConfig"
+                    }
+                },
+                "cost": {
+                    "type": "integer"
+                },
+                "default_restart": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "etag": {
+                    "description": "content hash of the stored import type; only set if requested (e.g. ImportTypeListOptions.WithEtag) and used as precondition on updates if not empty",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "description": "set to the image of the latest stable release, if one exists",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "output": {
+                    "$ref": "#/definitions/model.ContentVariable"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "releases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportTypeRelease"
+                    }
+                },
+                "tags": {
+                    "description": "free-form classification; empty tags, tags with commas and duplicates are not allowed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
             }
-        }
-    },
-    "definitions": {
-        "model.ContentVariable": {
+        },
+        "model.ImportTypeBulkOperation": {
             "type": "object",
             "properties": {
-                "aspect_id": {
+                "etag": {
                     "type": "string"
                 },
-                "characteristic_id": {
-                    "type": "string"
+                "force": {
+                    "description": "update: allow breaking changes without release version bump",
+                    "type": "boolean"
                 },
-                "function_id": {
+                "id": {
                     "type": "string"
                 },
-                "name": {
+                "import_type": {
+                    "$ref": "#/definitions/model.ImportType"
+                },
+                "operation": {
+                    "$ref": "#/definitions/model.BulkOperationType"
+                }
+            }
+        },
+        "model.ImportTypeBulkResult": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "set if an update is rejected because of breaking changes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportTypeChange"
+                    }
+                },
+                "code": {
+                    "type": "integer"
+                },
+                "error": {
                     "type": "string"
                 },
-                "sub_content_variables": {
+                "findings": {
+                    "description": "set if the import type is invalid",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.ContentVariable"
+                        "$ref": "#/definitions/model.ValidationFinding"
                     }
                 },
-                "type": {
-                    "$ref": "#/definitions/model.Type"
+                "id": {
+                    "type": "string"
                 },
-                "use_as_tag": {
-                    "type": "boolean"
+                "operation": {
+                    "$ref": "#/definitions/model.BulkOperationType"
                 }
             }
         },
-        "model.ImportConfig": {
+        "model.ImportTypeCategory": {
             "type": "object",
             "properties": {
-                "default_value": {},
                 "description": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                }
+            }
+        },
+        "model.ImportTypeChange": {
+            "type": "object",
+            "properties": {
+                "breaking": {
+                    "type": "boolean"
                 },
-                "type": {
-                    "$ref": "#/definitions/model.Type"
+                "kind": {
+                    "$ref": "#/definitions/model.ChangeKind"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
                 }
             }
         },
-        "model.ImportType": {
+        "model.ImportTypeDiff": {
             "type": "object",
             "properties": {
-                "configs": {
+                "breaking": {
+                    "type": "boolean"
+                },
+                "changes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.ImportConfig"
+                        "$ref": "#/definitions/model.ImportTypeChange"
+                    }
+                }
+            }
+        },
+        "model.ImportTypeFacets": {
+            "type": "object",
+            "properties": {
+                "aspects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
                     }
                 },
-                "cost": {
+                "characteristics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                },
+                "costs": {
+                    "description": "one entry per CostBuckets element, in the same order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CostBucketCount"
+                    }
+                },
+                "functions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FacetCount"
+                    }
+                }
+            }
+        },
+        "model.ImportTypeOwnershipTransfer": {
+            "type": "object",
+            "properties": {
+                "keep_read_access": {
+                    "description": "if true, the previous owner keeps read access",
+                    "type": "boolean"
+                },
+                "new_owner": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImportTypeRelease": {
+            "type": "object",
+            "properties": {
+                "changelog": {
+                    "type": "string"
+                },
+                "channel": {
+                    "$ref": "#/definitions/model.ReleaseChannel"
+                },
+                "image": {
+                    "description": "container image reference, e.g. ghcr.io/senergy-platform/import-weather:1.2.0",
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "semantic version 2.0.0 without \"v\" prefix, e.g. 1.2.0-beta.1",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ImportTypeRevision": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "import_type": {
+                    "$ref": "#/definitions/model.ImportType"
+                },
+                "import_type_id": {
+                    "type": "string"
+                },
+                "revision": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ImportTypeTagCount": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
                 },
-                "default_restart": {
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.JsonSchema": {
+            "type": "object",
+            "properties": {
+                "$schema": {
+                    "type": "string"
+                },
+                "additionalProperties": {
                     "type": "boolean"
                 },
+                "default": {},
                 "description": {
                     "type": "string"
                 },
-                "id": {
+                "enum": {
+                    "type": "array",
+                    "items": {}
+                },
+                "items": {
+                    "$ref": "#/definitions/model.JsonSchema"
+                },
+                "maxItems": {
+                    "type": "integer"
+                },
+                "maximum": {
+                    "type": "number"
+                },
+                "minItems": {
+                    "type": "integer"
+                },
+                "minimum": {
+                    "type": "number"
+                },
+                "pattern": {
                     "type": "string"
                 },
-                "image": {
+                "prefixItems": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.JsonSchema"
+                    }
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.JsonSchema"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
                     "type": "string"
                 },
-                "name": {
+                "type": {
                     "type": "string"
                 },
-                "output": {
-                    "$ref": "#/definitions/model.ContentVariable"
+                "writeOnly": {
+                    "type": "boolean"
                 },
-                "owner": {
+                "x-aspect-id": {
+                    "type": "string"
+                },
+                "x-characteristic-id": {
+                    "type": "string"
+                },
+                "x-function-id": {
+                    "type": "string"
+                },
+                "x-schema-org-type": {
+                    "$ref": "#/definitions/model.Type"
+                },
+                "x-use-as-tag": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.OutputCompatibility": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ImportTypeChange"
+                    }
+                },
+                "compatible": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.PermissionsMap": {
+            "type": "object",
+            "properties": {
+                "administrate": {
+                    "type": "boolean"
+                },
+                "execute": {
+                    "type": "boolean"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "write": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ReleaseChannel": {
+            "type": "string",
+            "enum": [
+                "stable",
+                "beta",
+                "deprecated"
+            ],
+            "x-enum-varnames": [
+                "ReleaseChannelStable",
+                "ReleaseChannelBeta",
+                "ReleaseChannelDeprecated"
+            ]
+        },
+        "model.ResourcePermissions": {
+            "type": "object",
+            "properties": {
+                "group_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                },
+                "role_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                },
+                "user_permissions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.PermissionsMap"
+                    }
+                }
+            }
+        },
+        "model.TrashedImportType": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deleted_by": {
+                    "description": "user who deleted the import type, or the removed user if it was deleted with its last administrator",
                     "type": "string"
+                },
+                "import_type": {
+                    "$ref": "#/definitions/model.ImportType"
+                },
+                "permissions": {
+                    "$ref": "#/definitions/model.ResourcePermissions"
                 }
             }
         },
@@ -458,6 +3073,78 @@ const docTemplate = `{
                 "List",
                 "Structure"
             ]
+        },
+        "model.ValidationError": {
+            "type": "object",
+            "properties": {
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ValidationFinding"
+                    }
+                }
+            }
+        },
+        "model.ValidationFinding": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/model.ValidationFindingCode"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "json pointer (RFC 6901) to the invalid field, e.g. /output/sub_content_variables/2/characteristic_id",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ValidationFindingCode": {
+            "type": "string",
+            "enum": [
+                "required",
+                "duplicate",
+                "invalid_type",
+                "invalid_default_value",
+                "not_allowed",
+                "unknown_reference",
+                "invalid_format",
+                "invalid_order",
+                "invalid_range",
+                "invalid_value",
+                "reference_check_failed"
+            ],
+            "x-enum-comments": {
+                "ValidationReferenceCheckFailed": "the device-repository could not be asked"
+            },
+            "x-enum-varnames": [
+                "ValidationRequired",
+                "ValidationDuplicate",
+                "ValidationInvalidType",
+                "ValidationInvalidDefaultValue",
+                "ValidationNotAllowed",
+                "ValidationUnknownReference",
+                "ValidationInvalidFormat",
+                "ValidationInvalidOrder",
+                "ValidationInvalidRange",
+                "ValidationInvalidValue",
+                "ValidationReferenceCheckFailed"
+            ]
+        },
+        "model.ValidationResult": {
+            "type": "object",
+            "properties": {
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ValidationFinding"
+                    }
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -17,6 +17,7 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"reflect"
 	"runtime"
@@ -52,6 +53,7 @@ func Start(config config.Config, control Controller) (err error) {
 		),
 		requestid.New(requestid.WithCustomHeaderStrKey("X-Request-ID")),
 		gin_mw.ErrorHandler(model.GetStatusCode, ", "),
+		ValidationErrorHandler,
 		gin_mw.StructRecoveryHandler(log.Logger, gin_mw.DefaultRecoveryFunc),
 	)
 	for _, e := range endpoints {
@@ -70,3 +72,21 @@ func Start(config config.Config, control Controller) (err error) {
 	}()
 	return nil
 }
+
+// ValidationErrorHandler responds with the findings of a *model.ValidationError or the changes of a *model.BreakingChangesError as json body
+// and has to be registered after gin_mw.ErrorHandler, which then ignores the aborted request
+func ValidationErrorHandler(c *gin.Context) {
+	c.Next()
+	for _, err := range c.Errors {
+		var validationErr *model.ValidationError
+		if errors.As(err.Err, &validationErr) {
+			c.AbortWithStatusJSON(model.GetStatusCode(err.Err), validationErr)
+			return
+		}
+		var breakingErr *model.BreakingChangesError
+		if errors.As(err.Err, &breakingErr) {
+			c.AbortWithStatusJSON(model.GetStatusCode(err.Err), breakingErr)
+			return
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeBulkEndpoints)
+}
+
+type importTypeBulkHandler struct {
+	control Controller
+}
+
+func ImportTypeBulkEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeBulkHandler{control: control}
+	router.POST("/import-types/bulk", handler.bulkImportTypes)
+}
+
+// bulkImportTypes godoc
+// @Summary Bulk create, update and delete import types
+// @Description Executes a list of operations and returns a result (id, code, error) per operation in the same order.
+// @Description create expects import_type; update expects import_type with id and optionally etag; delete expects id and optionally etag.
+// @Description All operations are checked before anything is written. Without atomic, every valid operation is executed on its own and the response code is 200.
+// @Description With atomic=true, all changes are written in one transaction, which is limited to 100 operations. If any operation fails, nothing is changed, the other operations report 424 and the response code is the code of the failed operation.
+// @Tags import-types
+// @Accept json
+// @Produce json
+// @Param atomic query bool false "Execute all operations or none"
+// @Param operations body []model.ImportTypeBulkOperation true "Operations"
+// @Success 200 {array} model.ImportTypeBulkResult
+// @Failure 400 {array} model.ImportTypeBulkResult
+// @Failure 403 {array} model.ImportTypeBulkResult
+// @Failure 404 {array} model.ImportTypeBulkResult
+// @Failure 412 {array} model.ImportTypeBulkResult
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/bulk [post]
+func (handler importTypeBulkHandler) bulkImportTypes(c *gin.Context) {
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	atomic := false
+	atomicParam := c.Query("atomic")
+	if atomicParam != "" {
+		atomic, err = strconv.ParseBool(atomicParam)
+		if err != nil {
+			_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse atomic"), err))
+			return
+		}
+	}
+	operations := []model.ImportTypeBulkOperation{}
+	err = c.ShouldBindJSON(&operations)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.BulkImportTypes(operations, atomic, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(code, result)
+}
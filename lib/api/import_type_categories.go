@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeCategoriesEndpoints)
+}
+
+type importTypeCategoriesHandler struct {
+	control Controller
+}
+
+func ImportTypeCategoriesEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	resource := "/import-type-categories"
+	handler := importTypeCategoriesHandler{control: control}
+
+	router.GET(resource, handler.listImportTypeCategories)
+	router.PUT(resource+"/:id", handler.setImportTypeCategory)
+	router.DELETE(resource+"/:id", handler.deleteImportTypeCategory)
+}
+
+// listImportTypeCategories godoc
+// @Summary List import type categories
+// @Description Returns the managed category list, ordered by name. The category of an import type has to be one of these ids.
+// @Tags categories
+// @Produce json
+// @Success 200 {array} model.ImportTypeCategory
+// @Failure 400 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-type-categories [get]
+func (handler importTypeCategoriesHandler) listImportTypeCategories(c *gin.Context) {
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.ListImportTypeCategories(token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// setImportTypeCategory godoc
+// @Summary Set import type category
+// @Description Creates or updates a category. Only admins may manage categories.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category id"
+// @Param category body model.ImportTypeCategory true "Category; the id has to match the path"
+// @Success 200 {object} model.ImportTypeCategory
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-type-categories/{id} [put]
+func (handler importTypeCategoriesHandler) setImportTypeCategory(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	category := model.ImportTypeCategory{}
+	err = c.ShouldBindJSON(&category)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	if category.Id != id {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("category id in body does not match path")))
+		return
+	}
+	result, err, code := handler.control.SetImportTypeCategory(category, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// deleteImportTypeCategory godoc
+// @Summary Delete import type category
+// @Description Removes a category, which is not used by any import type. Only admins may manage categories.
+// @Tags categories
+// @Param id path string true "Category id"
+// @Success 200
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 409 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-type-categories/{id} [delete]
+func (handler importTypeCategoriesHandler) deleteImportTypeCategory(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	err, code := handler.control.DeleteImportTypeCategory(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.Status(code)
+}
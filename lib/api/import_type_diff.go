@@ -0,0 +1,114 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeDiffEndpoints)
+}
+
+type importTypeDiffHandler struct {
+	control Controller
+}
+
+func ImportTypeDiffEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeDiffHandler{control: control}
+
+	router.GET("/import-types/:id/diff", handler.diffImportTypeRevision)
+	router.POST("/import-types/:id/diff", handler.diffImportType)
+}
+
+// diffImportTypeRevision godoc
+// @Summary Diff import type revision
+// @Description Lists the classified changes from a revision to the current version of an import type.
+// @Tags import-types, revisions
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param against query int true "Revision number"
+// @Success 200 {object} model.ImportTypeDiff
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/diff [get]
+func (handler importTypeDiffHandler) diffImportTypeRevision(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	revision, err := strconv.ParseInt(c.Query("against"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("against has to be a revision number"), err))
+		return
+	}
+	result, err, code := handler.control.DiffImportTypeRevision(id, revision, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// diffImportType godoc
+// @Summary Diff import type update
+// @Description Lists the classified changes an update to the import type in the request body would apply; breaking changes would be rejected by an update without force=true.
+// @Description Nothing is stored.
+// @Tags import-types
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param importType body model.ImportType true "Proposed import type"
+// @Success 200 {object} model.ImportTypeDiff
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/diff [post]
+func (handler importTypeDiffHandler) diffImportType(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	proposed := model.ImportType{}
+	err = c.ShouldBindJSON(&proposed)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.DiffImportType(id, proposed, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
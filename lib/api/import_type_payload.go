@@ -0,0 +1,107 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypePayloadEndpoints)
+}
+
+type importTypePayloadHandler struct {
+	control Controller
+}
+
+func ImportTypePayloadEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypePayloadHandler{control: control}
+
+	router.GET("/import-types/:id/sample", handler.getImportTypeSamplePayload)
+	router.POST("/import-types/:id/check-payload", handler.checkImportTypePayload)
+}
+
+// getImportTypeSamplePayload godoc
+// @Summary Get sample payload
+// @Description Generates a synthetic example message from the output of an import type.
+// @Tags import-types, payload
+// @Produce json
+// @Param id path string true "Import type id"
+// @Success 200 {object} object
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/sample [get]
+func (handler importTypePayloadHandler) getImportTypeSamplePayload(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypeSamplePayload(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// checkImportTypePayload godoc
+// @Summary Check payload
+// @Description Validates a message against the output of an import type: types, required sub content variables, list elements and values of tag fields.
+// @Description Findings are returned with status 200; their path is a json pointer into the message.
+// @Tags import-types, payload
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param payload body object true "Message"
+// @Success 200 {object} model.ValidationResult
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/check-payload [post]
+func (handler importTypePayloadHandler) checkImportTypePayload(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	var payload interface{}
+	err = c.ShouldBindJSON(&payload)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.CheckImportTypePayload(id, payload, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(code, result)
+}
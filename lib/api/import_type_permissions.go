@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypePermissionsEndpoints)
+}
+
+type importTypePermissionsHandler struct {
+	control Controller
+}
+
+func ImportTypePermissionsEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	resource := "/import-types/:id/permissions"
+	handler := importTypePermissionsHandler{control: control}
+
+	router.GET(resource, handler.getImportTypePermissions)
+	router.PUT(resource, handler.setImportTypePermissions)
+}
+
+// getImportTypePermissions godoc
+// @Summary Get import type permissions
+// @Description Returns the user, group and role permissions of an import type. Requires the administrate permission.
+// @Tags import-types, permissions
+// @Produce json
+// @Param id path string true "Import type id"
+// @Success 200 {object} permV2Model.ResourcePermissions
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/permissions [get]
+func (handler importTypePermissionsHandler) getImportTypePermissions(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypePermissions(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// setImportTypePermissions godoc
+// @Summary Set import type permissions
+// @Description Replaces the user, group and role permissions of an import type. Requires the administrate permission.
+// @Description At least one user has to keep the administrate permission. The admin role always keeps full access.
+// @Tags import-types, permissions
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param permissions body permV2Model.ResourcePermissions true "Permissions"
+// @Success 200 {object} permV2Model.ResourcePermissions
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/permissions [put]
+func (handler importTypePermissionsHandler) setImportTypePermissions(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	permissions := permV2Model.ResourcePermissions{}
+	err = c.ShouldBindJSON(&permissions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.SetImportTypePermissions(id, permissions, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
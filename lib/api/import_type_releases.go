@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeReleasesEndpoints)
+}
+
+type importTypeReleasesHandler struct {
+	control Controller
+}
+
+func ImportTypeReleasesEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeReleasesHandler{control: control}
+
+	router.GET("/import-types/:id/releases", handler.listImportTypeReleases)
+	router.POST("/import-types/:id/releases", handler.publishImportTypeRelease)
+}
+
+// listImportTypeReleases godoc
+// @Summary List import type releases
+// @Description Returns the releases of an import type, newest version first.
+// @Tags import-types, releases
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param channel query string false "Only return releases of this channel" Enums(stable, beta, deprecated)
+// @Success 200 {array} model.ImportTypeRelease
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/releases [get]
+func (handler importTypeReleasesHandler) listImportTypeReleases(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.ListImportTypeReleases(id, model.ReleaseChannel(c.Query("channel")), token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// publishImportTypeRelease godoc
+// @Summary Publish import type release
+// @Description Appends a release to the import type. The version has to be a semantic version higher than all existing versions and the image a valid image reference.
+// @Description The channel defaults to stable; the image of the import type is set to the image of the latest stable release.
+// @Tags import-types, releases
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param release body model.ImportTypeRelease true "Release; published_at is set by the server"
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "New version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid release (json findings) or other bad request (plain text)"
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 412 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/releases [post]
+func (handler importTypeReleasesHandler) publishImportTypeRelease(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	release := model.ImportTypeRelease{}
+	err = c.ShouldBindJSON(&release)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.PublishImportTypeRelease(id, release, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
+}
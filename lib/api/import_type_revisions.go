@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeRevisionsEndpoints)
+}
+
+type importTypeRevisionsHandler struct {
+	control Controller
+}
+
+func ImportTypeRevisionsEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	resource := "/import-types/:id/revisions"
+	handler := importTypeRevisionsHandler{control: control}
+
+	router.GET(resource, handler.listImportTypeRevisions)
+	router.GET(resource+"/:rev", handler.readImportTypeRevision)
+	router.POST(resource+"/:rev/restore", handler.restoreImportTypeRevision)
+}
+
+// listImportTypeRevisions godoc
+// @Summary List import type revisions
+// @Description Returns the stored revisions of an import type, newest first.
+// @Tags import-types
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param limit query int false "Maximum number of results" default(100)
+// @Param offset query int false "Result offset" default(0)
+// @Success 200 {array} model.ImportTypeRevision
+// @Header 200 {integer} X-Total-Count "Total number of revisions"
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/revisions [get]
+func (handler importTypeRevisionsHandler) listImportTypeRevisions(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+
+	listOptions := model.ImportTypeRevisionListOptions{
+		Limit:  100,
+		Offset: 0,
+	}
+	limitParam := c.Query("limit")
+	if limitParam != "" {
+		listOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+	}
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse limit"), err))
+		return
+	}
+
+	offsetParam := c.Query("offset")
+	if offsetParam != "" {
+		listOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+	}
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse offset"), err))
+		return
+	}
+
+	result, total, err, errCode := handler.control.ListImportTypeRevisions(id, token, listOptions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(errCode), err))
+		return
+	}
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.JSON(http.StatusOK, result)
+}
+
+// readImportTypeRevision godoc
+// @Summary Get import type revision
+// @Description Returns a single revision of an import type.
+// @Tags import-types
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} model.ImportTypeRevision
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/revisions/{rev} [get]
+func (handler importTypeRevisionsHandler) readImportTypeRevision(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	rev, err := strconv.ParseInt(c.Param("rev"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse revision"), err))
+		return
+	}
+	result, err, errCode := handler.control.ReadImportTypeRevision(id, rev, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(errCode), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// restoreImportTypeRevision godoc
+// @Summary Restore import type revision
+// @Description Replaces the import type with the snapshot of the given revision. The restore is recorded as a new revision.
+// @Description The snapshot is checked like an update; restores with breaking changes are rejected with 409, unless force=true is set.
+// @Tags import-types
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param rev path int true "Revision number"
+// @Param force query bool false "Apply breaking changes"
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "New version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid import type (json findings) or other bad request (plain text)"
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 409 {object} model.BreakingChangesError
+// @Failure 412 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/revisions/{rev}/restore [post]
+func (handler importTypeRevisionsHandler) restoreImportTypeRevision(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	rev, err := strconv.ParseInt(c.Param("rev"), 10, 64)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse revision"), err))
+		return
+	}
+	force, err := getForceParam(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, errCode := handler.control.RestoreImportTypeRevision(id, rev, force, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(errCode), err))
+		return
+	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeSchemaEndpoints)
+}
+
+type importTypeSchemaHandler struct {
+	control Controller
+}
+
+func ImportTypeSchemaEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeSchemaHandler{control: control}
+
+	router.GET("/import-types/:id/schema/configs", handler.getImportTypeConfigsSchema)
+	router.GET("/import-types/:id/schema/output", handler.getImportTypeOutputSchema)
+	router.GET("/import-types/:id/schema/avro", handler.getImportTypeAvroSchema)
+	router.GET("/import-types/:id/schema/proto", handler.getImportTypeProtoSchema)
+	router.POST("/import-types/:id/schema/compatibility", handler.checkImportTypeOutputCompatibility)
+}
+
+// getImportTypeConfigsSchema godoc
+// @Summary Get import type configs schema
+// @Description Returns a JSON Schema (draft 2020-12) of the config values accepted by the import type, keyed by config name.
+// @Description Default values of secret configs are never included.
+// @Tags import-types, schema
+// @Produce json
+// @Param id path string true "Import type id"
+// @Success 200 {object} model.JsonSchema
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/schema/configs [get]
+func (handler importTypeSchemaHandler) getImportTypeConfigsSchema(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypeConfigsSchema(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// getImportTypeOutputSchema godoc
+// @Summary Get import type output schema
+// @Description Returns a JSON Schema (draft 2020-12) of the messages produced by the import type.
+// @Description Characteristic, function and aspect ids are annotated as x-characteristic-id, x-function-id and x-aspect-id.
+// @Tags import-types, schema
+// @Produce json
+// @Param id path string true "Import type id"
+// @Success 200 {object} model.JsonSchema
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/schema/output [get]
+func (handler importTypeSchemaHandler) getImportTypeOutputSchema(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypeOutputSchema(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// getImportTypeAvroSchema godoc
+// @Summary Get import type avro schema
+// @Description Returns an avro schema of the messages produced by the import type. Structures and lists of fixed length are records, lists with a single "*" element are arrays.
+// @Description Invalid characters in names are replaced by '_', duplicates get a numeric suffix; the original name is kept as source_name.
+// @Description Characteristic, function and aspect ids and use_as_tag are kept as custom field attributes.
+// @Tags import-types, schema
+// @Produce json
+// @Param id path string true "Import type id"
+// @Success 200 {object} model.AvroRecord "record, or an array or primitive type for outputs which are not structures"
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/schema/avro [get]
+func (handler importTypeSchemaHandler) getImportTypeAvroSchema(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypeAvroSchema(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// getImportTypeProtoSchema godoc
+// @Summary Get import type protobuf schema
+// @Description Returns a proto3 definition of the messages produced by the import type. Messages are named like the avro records, field numbers follow the order of the sub content variables.
+// @Description Characteristic, function and aspect ids and use_as_tag are kept as custom field options.
+// @Tags import-types, schema
+// @Produce plain
+// @Param id path string true "Import type id"
+// @Success 200 {string} string
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/schema/proto [get]
+func (handler importTypeSchemaHandler) getImportTypeProtoSchema(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.GetImportTypeProtoSchema(id, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(result))
+}
+
+// checkImportTypeOutputCompatibility godoc
+// @Summary Check output compatibility
+// @Description Compares a proposed output with the current output of the import type. The proposed output is compatible, if its avro and protobuf schemas are able to read messages written with the current output (backward compatibility):
+// @Description sub content variables may be removed from the end, but not added (no avro default values) or moved (protobuf field numbers); types may not change; characteristics and use_as_tag may not change.
+// @Tags import-types, schema
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param output body model.ContentVariable true "Proposed output"
+// @Success 200 {object} model.OutputCompatibility
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/schema/compatibility [post]
+func (handler importTypeSchemaHandler) checkImportTypeOutputCompatibility(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	output := model.ContentVariable{}
+	err = c.ShouldBindJSON(&output)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.CheckImportTypeOutputCompatibility(id, output, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeTagsEndpoints)
+}
+
+type importTypeTagsHandler struct {
+	control Controller
+}
+
+func ImportTypeTagsEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeTagsHandler{control: control}
+	router.GET("/import-types/tags", handler.listImportTypeTags)
+}
+
+// listImportTypeTags godoc
+// @Summary List import type tags
+// @Description Returns the tags used by import types matching the filters, which are visible to the caller, with the number of import types per tag; most used first.
+// @Tags import-types
+// @Produce json
+// @Param ids query string false "Comma-separated import type ids"
+// @Param criteria query string false "JSON-encoded filter criteria array"
+// @Param tags query string false "Comma-separated tags; only import types with all tags are counted"
+// @Param categories query string false "Comma-separated category ids; only import types with one of the categories are counted"
+// @Param search query string false "Search term"
+// @Param search_mode query string false "text: weighted word search in name, description, config names and descriptions and output variable names; prefix: case-insensitive match anywhere in the name" Enums(text, prefix) default(text)
+// @Success 200 {array} model.ImportTypeTagCount
+// @Failure 400 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/tags [get]
+func (handler importTypeTagsHandler) listImportTypeTags(c *gin.Context) {
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	listOptions := model.ImportTypeListOptions{}
+	err = getListFilterParams(c, &listOptions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.ListImportTypeTags(token, listOptions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeTransferEndpoints)
+}
+
+type importTypeTransferHandler struct {
+	control Controller
+}
+
+func ImportTypeTransferEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeTransferHandler{control: control}
+	router.POST("/import-types/:id/transfer", handler.transferImportTypeOwnership)
+}
+
+// transferImportTypeOwnership godoc
+// @Summary Transfer import type ownership
+// @Description Sets a new owner and moves the permissions of the previous owner to the new owner. Only admins and the current owner may transfer an import type.
+// @Description If the permissions can not be updated, the owner change is reverted.
+// @Tags import-types, permissions
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param transfer body model.ImportTypeOwnershipTransfer true "Transfer"
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "New version of the import type"
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 412 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/transfer [post]
+func (handler importTypeTransferHandler) transferImportTypeOwnership(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	transfer := model.ImportTypeOwnershipTransfer{}
+	err = c.ShouldBindJSON(&transfer)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.TransferImportTypeOwnership(id, transfer, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
+}
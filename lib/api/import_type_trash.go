@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeTrashEndpoints)
+}
+
+type importTypeTrashHandler struct {
+	control Controller
+}
+
+func ImportTypeTrashEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeTrashHandler{control: control}
+
+	router.GET("/trash/import-types", handler.listTrashedImportTypes)
+	router.POST("/import-types/:id/restore", handler.restoreTrashedImportType)
+}
+
+// listTrashedImportTypes godoc
+// @Summary List deleted import types
+// @Description Returns deleted import types which have not been purged yet, most recently deleted first.
+// @Description Admins see all entries, other users the entries they were allowed to administrate.
+// @Tags import-types, trash
+// @Produce json
+// @Param limit query int false "Maximum number of results" default(100)
+// @Param offset query int false "Result offset" default(0)
+// @Success 200 {array} model.TrashedImportType
+// @Header 200 {integer} X-Total-Count "Total number of matching entries"
+// @Failure 400 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /trash/import-types [get]
+func (handler importTypeTrashHandler) listTrashedImportTypes(c *gin.Context) {
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+
+	listOptions := model.TrashListOptions{
+		Limit:  100,
+		Offset: 0,
+	}
+	limitParam := c.Query("limit")
+	if limitParam != "" {
+		listOptions.Limit, err = strconv.ParseInt(limitParam, 10, 64)
+	}
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse limit"), err))
+		return
+	}
+
+	offsetParam := c.Query("offset")
+	if offsetParam != "" {
+		listOptions.Offset, err = strconv.ParseInt(offsetParam, 10, 64)
+	}
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse offset"), err))
+		return
+	}
+
+	result, total, err, errCode := handler.control.ListTrashedImportTypes(token, listOptions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(errCode), err))
+		return
+	}
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.JSON(http.StatusOK, result)
+}
+
+// restoreTrashedImportType godoc
+// @Summary Restore deleted import type
+// @Description Moves a deleted import type back from the trash and restores the permissions it had when it was deleted.
+// @Description Requires the administrate permission at the time of deletion. The restore is recorded as a new revision.
+// @Description The import type is validated like an update and compared with its latest revision; restores with breaking changes are rejected with 409, unless force=true is set.
+// @Tags import-types, trash
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param force query bool false "Apply breaking changes"
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "Version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid import type (json findings) or other bad request (plain text)"
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 409 {object} model.BreakingChangesError "Breaking changes (json) or import type already exists (plain text)"
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/restore [post]
+func (handler importTypeTrashHandler) restoreTrashedImportType(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	force, err := getForceParam(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, errCode := handler.control.RestoreTrashedImportType(id, force, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(errCode), err))
+		return
+	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"errors"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	endpoints = append(endpoints, ImportTypeValidationEndpoints)
+}
+
+type importTypeValidationHandler struct {
+	control Controller
+}
+
+func ImportTypeValidationEndpoints(config config.Config, control Controller, router *gin.Engine) {
+	handler := importTypeValidationHandler{control: control}
+	router.POST(`/import-types\:validate`, handler.validateImportType) //escaped colon: literal path /import-types:validate
+	router.POST(`/import-types/:id/configs\:validate`, handler.validateImportTypeConfigValues)
+}
+
+// validateImportType godoc
+// @Summary Validate import type draft
+// @Description Validates an import type, including the references to characteristics, functions and aspects at the device-repository, without storing it.
+// @Description Validation happens independent of the validate config flag. Findings are returned with status 200; findings with the code reference_check_failed mean that the device-repository could not be asked.
+// @Tags import-types
+// @Accept json
+// @Produce json
+// @Param importType body model.ImportType true "Import type draft"
+// @Success 200 {object} model.ValidationResult
+// @Failure 400 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types:validate [post]
+func (handler importTypeValidationHandler) validateImportType(c *gin.Context) {
+	importType := model.ImportType{}
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	err = c.ShouldBindJSON(&importType)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.ValidateImportTypeDraft(importType, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(code, result)
+}
+
+// validateImportTypeConfigValues godoc
+// @Summary Validate config values
+// @Description Validates config values, e.g. of a planned deployment, against the configs of an import type: unknown configs, missing required values without default, types, enums, ranges and patterns.
+// @Description Findings are returned with status 200; their path is a json pointer to the value.
+// @Tags import-types
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param values body object true "Config values by config name"
+// @Success 200 {object} model.ValidationResult
+// @Failure 400 {string} ErrorResponse
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id}/configs:validate [post]
+func (handler importTypeValidationHandler) validateImportTypeConfigValues(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	values := map[string]interface{}{}
+	err = c.ShouldBindJSON(&values)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.ValidateImportTypeConfigValues(id, values, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	c.JSON(code, result)
+}
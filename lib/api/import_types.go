@@ -19,7 +19,9 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -45,22 +47,32 @@ func ImportTypesEndpoints(config config.Config, control Controller, router *gin.
 	router.GET(resource+"/:id", handler.readImportType)
 	router.DELETE(resource+"/:id", handler.deleteImportType)
 	router.PUT(resource+"/:id", handler.setImportType)
+	router.PATCH(resource+"/:id", handler.patchImportType)
 	router.POST(resource, handler.createImportType)
 }
 
 // listImportTypes godoc
 // @Summary List import types
-// @Description Returns import types visible to the caller. If `ids` is provided, pagination is ignored.
+// @Description Returns import types visible to the caller. `ids` filters the result, which is still paginated.
 // @Tags import-types
 // @Produce json
 // @Param limit query int false "Maximum number of results" default(100)
-// @Param offset query int false "Result offset" default(0)
+// @Param offset query int false "Result offset; ignored if cursor is set" default(0)
+// @Param cursor query string false "Opaque cursor from the X-Next-Cursor header of the previous page; requires the same sort"
+// @Param with_total query bool false "Count the total number of matching import types" default(true)
 // @Param ids query string false "Comma-separated import type ids"
 // @Param criteria query string false "JSON-encoded filter criteria array"
-// @Param search query string false "Free-text search term"
-// @Param sort query string false "Sort order" default(name.asc)
+// @Param tags query string false "Comma-separated tags; only import types with all tags are listed"
+// @Param categories query string false "Comma-separated category ids; only import types with one of the categories are listed"
+// @Param search query string false "Search term"
+// @Param search_mode query string false "text: weighted word search in name, description, config names and descriptions and output variable names; prefix: case-insensitive match anywhere in the name" Enums(text, prefix) default(text)
+// @Param sort query string false "Sort order as field.asc or field.desc, or relevance for text searches" default(name.asc)
+// @Param with_etag query bool false "Include the etag of each import type in the result"
+// @Param extended query bool false "Return model.ImportTypeExtended instead of model.ImportType"
+// @Param resolve_references query bool false "Return model.ImportTypeExtended with the device-repository metadata of used aspects, functions and characteristics"
 // @Success 200 {array} model.ImportType
-// @Header 200 {integer} X-Total-Count "Total number of matching import types"
+// @Header 200 {integer} X-Total-Count "Total number of matching import types; missing if with_total=false"
+// @Header 200 {string} X-Next-Cursor "Cursor of the next page; missing on the last page or if the sort does not support cursors (supported: id, name, description, image, owner, cost)"
 // @Failure 400 {string} ErrorResponse
 // @Failure 403 {string} ErrorResponse
 // @Failure 500 {string} ErrorResponse
@@ -95,47 +107,126 @@ func (handler importTypesHandler) listImportTypes(c *gin.Context) {
 		return
 	}
 
-	idsParam := c.Query("ids")
-	if _, hasIds := c.GetQuery("ids"); hasIds {
-		if idsParam != "" {
-			listOptions.Ids = strings.Split(strings.TrimSpace(idsParam), ",")
-		} else {
-			listOptions.Ids = []string{}
+	err = getListFilterParams(c, &listOptions)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+
+	withEtagParam := c.Query("with_etag")
+	if withEtagParam != "" {
+		listOptions.WithEtag, err = strconv.ParseBool(withEtagParam)
+		if err != nil {
+			_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse with_etag"), err))
+			return
 		}
 	}
 
-	criteria := c.Query("criteria")
-	if criteria != "" {
-		listOptions.Criteria = []model.ImportTypeFilterCriteria{}
-		err = json.Unmarshal([]byte(criteria), &listOptions.Criteria)
+	withTotalParam := c.Query("with_total")
+	if withTotalParam != "" {
+		withTotal, err := strconv.ParseBool(withTotalParam)
 		if err != nil {
-			_ = c.Error(errors.Join(model.ErrBadRequest, err))
+			_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unable to parse with_total"), err))
 			return
 		}
+		listOptions.WithoutTotal = !withTotal
 	}
 
-	listOptions.Search = c.Query("search")
 	listOptions.SortBy = c.Query("sort")
 	if listOptions.SortBy == "" {
 		listOptions.SortBy = "name.asc"
 	}
 
+	cursorParam := c.Query("cursor")
+	if cursorParam != "" {
+		cursor, err := model.DecodeImportTypeCursor(cursorParam)
+		if err != nil {
+			_ = c.Error(errors.Join(model.ErrBadRequest, err))
+			return
+		}
+		listOptions.After = &cursor
+	}
+
+	extended, resolveReferences, err := getExtendedParams(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	if extended {
+		result, total, err, errCode := handler.control.ListImportTypesExtended(token, listOptions, resolveReferences)
+		if err != nil {
+			_ = c.Error(errors.Join(model.GetError(errCode), err))
+			return
+		}
+		setListHeaders(c, listOptions, total, result)
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
 	result, total, err, errCode := handler.control.ListImportTypes(token, listOptions)
 	if err != nil {
 		_ = c.Error(errors.Join(model.GetError(errCode), err))
 		return
 	}
-	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	setListHeaders(c, listOptions, total, result)
 	c.JSON(http.StatusOK, result)
 }
 
+// getListFilterParams reads the ids, criteria, tags, categories, search and search_mode parameters, which are shared by list, facet and tag queries
+func getListFilterParams(c *gin.Context, listOptions *model.ImportTypeListOptions) error {
+	idsParam := c.Query("ids")
+	if _, hasIds := c.GetQuery("ids"); hasIds {
+		if idsParam != "" {
+			listOptions.Ids = strings.Split(strings.TrimSpace(idsParam), ",")
+		} else {
+			listOptions.Ids = []string{}
+		}
+	}
+
+	criteria := c.Query("criteria")
+	if criteria != "" {
+		listOptions.Criteria = []model.ImportTypeFilterCriteria{}
+		err := json.Unmarshal([]byte(criteria), &listOptions.Criteria)
+		if err != nil {
+			return err
+		}
+	}
+
+	if tags := c.Query("tags"); tags != "" {
+		listOptions.Tags = strings.Split(tags, ",")
+	}
+	if categories := c.Query("categories"); categories != "" {
+		listOptions.Categories = strings.Split(categories, ",")
+	}
+
+	listOptions.Search = c.Query("search")
+	listOptions.SearchMode = model.SearchMode(c.Query("search_mode"))
+	return nil
+}
+
+// setListHeaders sets X-Total-Count, if the total was counted, and X-Next-Cursor, if a further page may exist and the sort supports cursors
+func setListHeaders[T model.ImportType | model.ImportTypeExtended](c *gin.Context, options model.ImportTypeListOptions, total int64, page []T) {
+	if !options.WithoutTotal {
+		c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	}
+	cursor, err := model.NextImportTypeCursor(options.SortBy, options.Limit, page)
+	if err == nil && cursor != "" {
+		c.Header("X-Next-Cursor", cursor)
+	}
+}
+
 // readImportType godoc
 // @Summary Get import type
-// @Description Returns a single import type by id.
+// @Description Returns a single import type by id. The current version is returned in the ETag header.
 // @Tags import-types
 // @Produce json
 // @Param id path string true "Import type id"
+// @Param If-None-Match header string false "Etag of a known version; responds with 304 if unchanged"
+// @Param extended query bool false "Return model.ImportTypeExtended instead of model.ImportType; no ETag is returned for the extended form"
+// @Param resolve_references query bool false "Return model.ImportTypeExtended with the device-repository metadata of used aspects, functions and characteristics"
 // @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "Version of the import type"
+// @Success 304
 // @Failure 400 {string} ErrorResponse
 // @Failure 403 {string} ErrorResponse
 // @Failure 404 {string} ErrorResponse
@@ -149,11 +240,31 @@ func (handler importTypesHandler) readImportType(c *gin.Context) {
 		_ = c.Error(errors.Join(model.ErrBadRequest, err))
 		return
 	}
+	extended, resolveReferences, err := getExtendedParams(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	if extended {
+		result, err, errCode := handler.control.ReadImportTypeExtended(id, token, resolveReferences)
+		if err != nil {
+			_ = c.Error(errors.Join(model.GetError(errCode), err))
+			return
+		}
+		c.JSON(http.StatusOK, result)
+		return
+	}
 	result, err, errCode := handler.control.ReadImportType(id, token)
 	if err != nil {
 		_ = c.Error(errors.Join(model.GetError(errCode), err))
 		return
 	}
+	setEtagHeader(c, result.Etag)
+	if etagListMatches(c.GetHeader("If-None-Match"), result.Etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	result.Etag = ""
 	c.JSON(http.StatusOK, result)
 }
 
@@ -162,10 +273,12 @@ func (handler importTypesHandler) readImportType(c *gin.Context) {
 // @Description Deletes an import type by id.
 // @Tags import-types
 // @Param id path string true "Import type id"
+// @Param If-Match header string false "Etag of the expected version"
 // @Success 200
 // @Failure 400 {string} ErrorResponse
 // @Failure 403 {string} ErrorResponse
 // @Failure 404 {string} ErrorResponse
+// @Failure 412 {string} ErrorResponse
 // @Failure 500 {string} ErrorResponse
 // @Security Bearer
 // @Router /import-types/{id} [delete]
@@ -176,7 +289,12 @@ func (handler importTypesHandler) deleteImportType(c *gin.Context) {
 		_ = c.Error(errors.Join(model.ErrBadRequest, err))
 		return
 	}
-	err, errCode := handler.control.DeleteImportType(id, token)
+	etag, err := getIfMatchEtag(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	err, errCode := handler.control.DeleteImportTypeIfMatch(id, etag, token)
 	if err != nil {
 		_ = c.Error(errors.Join(model.GetError(errCode), err))
 		return
@@ -187,14 +305,22 @@ func (handler importTypesHandler) deleteImportType(c *gin.Context) {
 // setImportType godoc
 // @Summary Update import type
 // @Description Replaces an import type. The request body id must match the path id.
+// @Description If an etag is provided by the If-Match header or the etag field of the body, the update is only applied if it matches the stored version.
+// @Description Updates with breaking changes (e.g. removed output sub content variables or configs, changed types) are rejected with 409, unless force=true is set or the update adds a release with a higher major version (minor version for 0.x).
 // @Tags import-types
 // @Accept json
+// @Produce json
 // @Param id path string true "Import type id"
+// @Param If-Match header string false "Etag of the expected version"
+// @Param force query bool false "Apply breaking changes"
 // @Param importType body model.ImportType true "Full import type payload"
-// @Success 200
-// @Failure 400 {string} ErrorResponse
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "New version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid import type (json findings) or other bad request (plain text)"
 // @Failure 403 {string} ErrorResponse
 // @Failure 404 {string} ErrorResponse
+// @Failure 409 {object} model.BreakingChangesError
+// @Failure 412 {string} ErrorResponse
 // @Failure 500 {string} ErrorResponse
 // @Security Bearer
 // @Router /import-types/{id} [put]
@@ -215,12 +341,89 @@ func (handler importTypesHandler) setImportType(c *gin.Context) {
 		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("IDs don't match")))
 		return
 	}
-	err, code := handler.control.SetImportType(importType, token)
+	if c.GetHeader("If-Match") != "" {
+		importType.Etag, err = getIfMatchEtag(c)
+		if err != nil {
+			_ = c.Error(errors.Join(model.ErrBadRequest, err))
+			return
+		}
+	}
+	force, err := getForceParam(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.SetImportTypeWithOptions(importType, force, token)
 	if err != nil {
 		_ = c.Error(errors.Join(model.GetError(code), err))
 		return
 	}
-	c.Status(http.StatusOK)
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
+}
+
+// patchImportType godoc
+// @Summary Patch import type
+// @Description Partially updates an import type. Accepts merge patches (RFC 7396, Content-Type application/merge-patch+json or application/json) and json patches (RFC 6902, Content-Type application/json-patch+json).
+// @Description The patched import type is validated and checked like a full update. Id and owner may not be changed.
+// @Tags import-types
+// @Accept json
+// @Produce json
+// @Param id path string true "Import type id"
+// @Param If-Match header string false "Etag of the expected version"
+// @Param force query bool false "Apply breaking changes"
+// @Param patch body object true "Merge patch or json patch document"
+// @Success 200 {object} model.ImportType
+// @Header 200 {string} ETag "New version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid import type (json findings) or other bad request (plain text)"
+// @Failure 403 {string} ErrorResponse
+// @Failure 404 {string} ErrorResponse
+// @Failure 409 {object} model.BreakingChangesError
+// @Failure 412 {string} ErrorResponse
+// @Failure 500 {string} ErrorResponse
+// @Security Bearer
+// @Router /import-types/{id} [patch]
+func (handler importTypesHandler) patchImportType(c *gin.Context) {
+	id := c.Param("id")
+	token, err := jwt.GetParsedToken(c.Request)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	var patchType model.PatchType
+	switch c.ContentType() {
+	case string(model.JsonPatch):
+		patchType = model.JsonPatch
+	case string(model.MergePatch), "application/json", "":
+		patchType = model.MergePatch
+	default:
+		_ = c.Error(errors.Join(model.ErrBadRequest, errors.New("unsupported content type "+c.ContentType())))
+		return
+	}
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	etag, err := getIfMatchEtag(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	force, err := getForceParam(c)
+	if err != nil {
+		_ = c.Error(errors.Join(model.ErrBadRequest, err))
+		return
+	}
+	result, err, code := handler.control.PatchImportType(id, patchType, patch, etag, force, token)
+	if err != nil {
+		_ = c.Error(errors.Join(model.GetError(code), err))
+		return
+	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
+	c.JSON(http.StatusOK, result)
 }
 
 // createImportType godoc
@@ -231,7 +434,8 @@ func (handler importTypesHandler) setImportType(c *gin.Context) {
 // @Produce json
 // @Param importType body model.ImportType true "Import type payload"
 // @Success 200 {object} model.ImportType
-// @Failure 400 {string} ErrorResponse
+// @Header 200 {string} ETag "Version of the import type"
+// @Failure 400 {object} model.ValidationError "Invalid import type (json findings) or other bad request (plain text)"
 // @Failure 403 {string} ErrorResponse
 // @Failure 500 {string} ErrorResponse
 // @Security Bearer
@@ -253,5 +457,78 @@ func (handler importTypesHandler) createImportType(c *gin.Context) {
 		_ = c.Error(errors.Join(model.GetError(code), err))
 		return
 	}
+	setEtagHeader(c, result.Etag)
+	result.Etag = ""
 	c.JSON(code, result)
 }
+
+// getExtendedParams parses the extended and resolve_references query parameters; resolve_references implies extended
+func getExtendedParams(c *gin.Context) (extended bool, resolveReferences bool, err error) {
+	if param := c.Query("extended"); param != "" {
+		extended, err = strconv.ParseBool(param)
+		if err != nil {
+			return false, false, errors.Join(errors.New("unable to parse extended"), err)
+		}
+	}
+	if param := c.Query("resolve_references"); param != "" {
+		resolveReferences, err = strconv.ParseBool(param)
+		if err != nil {
+			return false, false, errors.Join(errors.New("unable to parse resolve_references"), err)
+		}
+	}
+	return extended || resolveReferences, resolveReferences, nil
+}
+
+func setEtagHeader(c *gin.Context, etag string) {
+	if etag != "" {
+		c.Header("ETag", strconv.Quote(etag))
+	}
+}
+
+// getIfMatchEtag returns the etag of the If-Match header; returns an empty string if the header is missing or '*'
+func getIfMatchEtag(c *gin.Context) (etag string, err error) {
+	etags := parseEtagList(c.GetHeader("If-Match"))
+	if len(etags) == 0 || slices.Contains(etags, "*") {
+		return "", nil
+	}
+	if len(etags) > 1 {
+		return "", errors.New("If-Match with multiple etags is not supported")
+	}
+	return etags[0], nil
+}
+
+func etagListMatches(header string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	for _, e := range parseEtagList(header) {
+		if e == "*" || e == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func parseEtagList(header string) (result []string) {
+	for _, e := range strings.Split(header, ",") {
+		e = strings.TrimSpace(e)
+		e = strings.TrimPrefix(e, "W/")
+		e = strings.Trim(e, `"`)
+		if e != "" {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func getForceParam(c *gin.Context) (force bool, err error) {
+	forceParam := c.Query("force")
+	if forceParam == "" {
+		return false, nil
+	}
+	force, err = strconv.ParseBool(forceParam)
+	if err != nil {
+		return false, errors.Join(errors.New("unable to parse force"), err)
+	}
+	return force, nil
+}
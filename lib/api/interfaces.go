@@ -18,13 +18,50 @@ package api
 
 import (
 	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 )
 
 type Controller interface {
 	ReadImportType(id string, token jwt.Token) (result model.ImportType, err error, errCode int)
 	ListImportTypes(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportType, total int64, err error, errCode int)
+	ReadImportTypeExtended(id string, token jwt.Token, resolveReferences bool) (result model.ImportTypeExtended, err error, code int)
+	ListImportTypesExtended(token jwt.Token, options model.ImportTypeListOptions, resolveReferences bool) (result []model.ImportTypeExtended, total int64, err error, code int)
+	GetImportTypeFacets(token jwt.Token, options model.ImportTypeListOptions) (result model.ImportTypeFacets, err error, code int)
+	ListImportTypeTags(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error, code int)
 	CreateImportType(importType model.ImportType, token jwt.Token) (result model.ImportType, err error, code int)
 	SetImportType(importType model.ImportType, token jwt.Token) (err error, code int)
+	SetImportTypeWithOptions(importType model.ImportType, force bool, token jwt.Token) (result model.ImportType, err error, code int)
+	PatchImportType(id string, patchType model.PatchType, patch []byte, etag string, force bool, token jwt.Token) (result model.ImportType, err error, code int)
+	DiffImportType(id string, proposed model.ImportType, token jwt.Token) (result model.ImportTypeDiff, err error, code int)
 	DeleteImportType(id string, token jwt.Token) (err error, errCode int)
+	DeleteImportTypeIfMatch(id string, etag string, token jwt.Token) (err error, errCode int)
+	ValidateImportTypeDraft(importType model.ImportType, token jwt.Token) (result model.ValidationResult, err error, code int)
+	ValidateImportTypeConfigValues(id string, values map[string]interface{}, token jwt.Token) (result model.ValidationResult, err error, code int)
+	BulkImportTypes(operations []model.ImportTypeBulkOperation, atomic bool, token jwt.Token) (result []model.ImportTypeBulkResult, err error, code int)
+
+	ListImportTypeCategories(token jwt.Token) (result []model.ImportTypeCategory, err error, code int)
+	SetImportTypeCategory(category model.ImportTypeCategory, token jwt.Token) (result model.ImportTypeCategory, err error, code int)
+	DeleteImportTypeCategory(id string, token jwt.Token) (err error, code int)
+
+	GetImportTypePermissions(id string, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int)
+	SetImportTypePermissions(id string, permissions permV2Model.ResourcePermissions, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int)
+	PublishImportTypeRelease(id string, release model.ImportTypeRelease, token jwt.Token) (result model.ImportType, err error, code int)
+	ListImportTypeReleases(id string, channel model.ReleaseChannel, token jwt.Token) (result []model.ImportTypeRelease, err error, code int)
+	TransferImportTypeOwnership(id string, transfer model.ImportTypeOwnershipTransfer, token jwt.Token) (result model.ImportType, err error, code int)
+	GetImportTypeConfigsSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int)
+	GetImportTypeOutputSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int)
+	GetImportTypeAvroSchema(id string, token jwt.Token) (result interface{}, err error, code int)
+	GetImportTypeProtoSchema(id string, token jwt.Token) (result string, err error, code int)
+	CheckImportTypeOutputCompatibility(id string, output model.ContentVariable, token jwt.Token) (result model.OutputCompatibility, err error, code int)
+	GetImportTypeSamplePayload(id string, token jwt.Token) (result interface{}, err error, code int)
+	CheckImportTypePayload(id string, payload interface{}, token jwt.Token) (result model.ValidationResult, err error, code int)
+
+	ListTrashedImportTypes(token jwt.Token, options model.TrashListOptions) (result []model.TrashedImportType, total int64, err error, code int)
+	RestoreTrashedImportType(id string, force bool, token jwt.Token) (result model.ImportType, err error, code int)
+
+	ListImportTypeRevisions(id string, token jwt.Token, options model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error, errCode int)
+	ReadImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeRevision, err error, errCode int)
+	RestoreImportTypeRevision(id string, revision int64, force bool, token jwt.Token) (result model.ImportType, err error, errCode int)
+	DiffImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeDiff, err error, code int)
 }
@@ -21,12 +21,21 @@ import (
 	"fmt"
 	"github.com/SENERGY-Platform/import-repository/lib/api"
 	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
 	"io"
+	"iter"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
-type Interface = api.Controller
+type Interface interface {
+	api.Controller
+
+	// client only helpers
+	ListImportTypesPage(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportType, total int64, next *model.ImportTypeCursor, err error, code int)
+	IterateImportTypes(token jwt.Token, options model.ImportTypeListOptions) iter.Seq2[model.ImportType, error]
+}
 
 type Client struct {
 	baseUrl string
@@ -44,7 +53,7 @@ func do[T any](req *http.Request) (result T, err error, code int) {
 	defer resp.Body.Close()
 	if resp.StatusCode > 299 {
 		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
-		return result, fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp)), resp.StatusCode
+		return result, responseError(resp, temp), resp.StatusCode
 	}
 	err = json.NewDecoder(resp.Body).Decode(&result)
 	if err != nil {
@@ -54,6 +63,54 @@ func do[T any](req *http.Request) (result T, err error, code int) {
 	return
 }
 
+func doWithEtag[T any](req *http.Request) (result T, etag string, err error, code int) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return result, etag, err, http.StatusInternalServerError
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
+		return result, etag, responseError(resp, temp), resp.StatusCode
+	}
+	etag = strings.Trim(strings.TrimPrefix(resp.Header.Get("ETag"), "W/"), `"`)
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		_, _ = io.ReadAll(resp.Body) //ensure resp.Body is read to EOF
+		return result, etag, err, http.StatusInternalServerError
+	}
+	return result, etag, nil, resp.StatusCode
+}
+
+func doText(req *http.Request) (result string, err error, code int) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	defer resp.Body.Close()
+	temp, err := io.ReadAll(resp.Body)
+	if resp.StatusCode > 299 {
+		return result, responseError(resp, temp), resp.StatusCode
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return string(temp), nil, resp.StatusCode
+}
+
+func doVoid(req *http.Request) (err error, code int) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	defer resp.Body.Close()
+	temp, _ := io.ReadAll(resp.Body) //ensure resp.Body is read to EOF
+	if resp.StatusCode > 299 {
+		return responseError(resp, temp), resp.StatusCode
+	}
+	return nil, resp.StatusCode
+}
+
 func doWithTotalInResult[T any](req *http.Request) (result T, total int64, err error, code int) {
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -62,11 +119,14 @@ func doWithTotalInResult[T any](req *http.Request) (result T, total int64, err e
 	defer resp.Body.Close()
 	if resp.StatusCode > 299 {
 		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
-		return result, total, fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(temp)), resp.StatusCode
+		return result, total, responseError(resp, temp), resp.StatusCode
 	}
-	total, err = strconv.ParseInt(resp.Header.Get("X-Total-Count"), 10, 64)
-	if err != nil {
-		return result, total, fmt.Errorf("unable to read X-Total-Count header %w", err), http.StatusInternalServerError
+	total = -1 //not counted
+	if totalHeader := resp.Header.Get("X-Total-Count"); totalHeader != "" {
+		total, err = strconv.ParseInt(totalHeader, 10, 64)
+		if err != nil {
+			return result, total, fmt.Errorf("unable to read X-Total-Count header %w", err), http.StatusInternalServerError
+		}
 	}
 	err = json.NewDecoder(resp.Body).Decode(&result)
 	if err != nil {
@@ -76,5 +136,23 @@ func doWithTotalInResult[T any](req *http.Request) (result T, total int64, err e
 	return
 }
 
+// responseError returns a *model.ValidationError if the response contains validation findings
+// and a *model.BreakingChangesError if it contains breaking changes
+func responseError(resp *http.Response, body []byte) error {
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		validationErr := &model.ValidationError{}
+		err := json.Unmarshal(body, validationErr)
+		if err == nil && len(validationErr.Findings) > 0 {
+			return validationErr
+		}
+		breakingErr := &model.BreakingChangesError{}
+		err = json.Unmarshal(body, breakingErr)
+		if err == nil && len(breakingErr.Changes) > 0 {
+			return breakingErr
+		}
+	}
+	return fmt.Errorf("unexpected statuscode %v: %v", resp.StatusCode, string(body))
+}
+
 type ImportTypeListOptions = model.ImportTypeListOptions
 type ImportTypeFilterCriteria = model.ImportTypeFilterCriteria
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// BulkImportTypes returns the per operation results; a failed atomic request returns the results with a nil error and the code of the failed operation
+func (c Client) BulkImportTypes(operations []model.ImportTypeBulkOperation, atomic bool, token jwt.Token) (result []model.ImportTypeBulkResult, err error, code int) {
+	body, err := json.Marshal(operations)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	queryString := ""
+	if atomic {
+		queryString = "?atomic=true"
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/bulk"+queryString, bytes.NewBuffer(body))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	defer resp.Body.Close()
+	temp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	err = json.Unmarshal(temp, &result)
+	if err != nil {
+		if resp.StatusCode > 299 {
+			return nil, responseError(resp, temp), resp.StatusCode
+		}
+		return nil, err, http.StatusInternalServerError
+	}
+	return result, nil, resp.StatusCode
+}
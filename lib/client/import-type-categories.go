@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) ListImportTypeCategories(token jwt.Token) (result []model.ImportTypeCategory, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-type-categories", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[[]model.ImportTypeCategory](req)
+}
+
+func (c Client) SetImportTypeCategory(category model.ImportTypeCategory, token jwt.Token) (result model.ImportTypeCategory, err error, code int) {
+	b, err := json.Marshal(category)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/import-type-categories/"+url.PathEscape(category.Id), bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ImportTypeCategory](req)
+}
+
+func (c Client) DeleteImportTypeCategory(id string, token jwt.Token) (err error, code int) {
+	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+"/import-type-categories/"+url.PathEscape(id), nil)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doVoid(req)
+}
+
+// ListImportTypeTags uses the ids, criteria, tags, categories, search and search mode of the options; all other fields are ignored
+func (c Client) ListImportTypeTags(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error, code int) {
+	query, err := importTypeListQuery(model.ImportTypeListOptions{
+		Ids:        options.Ids,
+		Search:     options.Search,
+		SearchMode: options.SearchMode,
+		Criteria:   options.Criteria,
+		Tags:       options.Tags,
+		Categories: options.Categories,
+	})
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/tags"+encodeQuery(query), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[[]model.ImportTypeTagCount](req)
+}
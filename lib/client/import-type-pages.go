@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ListImportTypesPage works like ListImportTypes and additionally returns the cursor of the next page, which is empty on the last page.
+// the next page can be requested by setting options.After to the returned cursor.
+func (c Client) ListImportTypesPage(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportType, total int64, next *model.ImportTypeCursor, err error, code int) {
+	total = -1
+	query, err := importTypeListQuery(options)
+	if err != nil {
+		return result, total, next, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types"+encodeQuery(query), nil)
+	if err != nil {
+		return result, total, next, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return result, total, next, err, http.StatusInternalServerError
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		temp, _ := io.ReadAll(resp.Body) //read error response end ensure that resp.Body is read to EOF
+		return result, total, next, responseError(resp, temp), resp.StatusCode
+	}
+	if totalHeader := resp.Header.Get("X-Total-Count"); totalHeader != "" {
+		total, err = strconv.ParseInt(totalHeader, 10, 64)
+		if err != nil {
+			return result, total, next, fmt.Errorf("unable to read X-Total-Count header %w", err), http.StatusInternalServerError
+		}
+	}
+	if cursorHeader := resp.Header.Get("X-Next-Cursor"); cursorHeader != "" {
+		cursor, err := model.DecodeImportTypeCursor(cursorHeader)
+		if err != nil {
+			return result, total, next, fmt.Errorf("unable to read X-Next-Cursor header %w", err), http.StatusInternalServerError
+		}
+		next = &cursor
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		_, _ = io.ReadAll(resp.Body) //ensure resp.Body is read to EOF
+		return result, total, next, err, http.StatusInternalServerError
+	}
+	return result, total, next, nil, resp.StatusCode
+}
+
+// IterateImportTypes walks through all import types matching the options, requesting one page of options.Limit elements at a time with cursors.
+// options.Offset and options.After are used for the first page only; the total is not counted.
+// iteration stops after the first error, which is yielded with an empty import type.
+func (c Client) IterateImportTypes(token jwt.Token, options model.ImportTypeListOptions) iter.Seq2[model.ImportType, error] {
+	return func(yield func(model.ImportType, error) bool) {
+		if options.SortBy != "" && !slices.Contains(model.CursorSortFields, model.SortField(options.SortBy)) {
+			yield(model.ImportType{}, fmt.Errorf("sort %v does not support cursor pagination", options.SortBy))
+			return
+		}
+		options.WithoutTotal = true
+		for {
+			page, _, next, err, _ := c.ListImportTypesPage(token, options)
+			if err != nil {
+				yield(model.ImportType{}, err)
+				return
+			}
+			for _, importType := range page {
+				if !yield(importType, nil) {
+					return
+				}
+			}
+			if next == nil {
+				return
+			}
+			options.After = next
+			options.Offset = 0
+		}
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) GetImportTypeSamplePayload(id string, token jwt.Token) (result interface{}, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/sample", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[interface{}](req)
+}
+
+// CheckImportTypePayload validates a message against the output of the import type; findings are part of the result and not returned as error
+func (c Client) CheckImportTypePayload(id string, payload interface{}, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/check-payload", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ValidationResult](req)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) GetImportTypePermissions(id string, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/permissions", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[permV2Model.ResourcePermissions](req)
+}
+
+func (c Client) SetImportTypePermissions(id string, permissions permV2Model.ResourcePermissions, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int) {
+	b, err := json.Marshal(permissions)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/permissions", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[permV2Model.ResourcePermissions](req)
+}
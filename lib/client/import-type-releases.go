@@ -0,0 +1,54 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) PublishImportTypeRelease(id string, release model.ImportTypeRelease, token jwt.Token) (result model.ImportType, err error, code int) {
+	b, err := json.Marshal(release)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/releases", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	result, result.Etag, err, code = doWithEtag[model.ImportType](req)
+	return result, err, code
+}
+
+func (c Client) ListImportTypeReleases(id string, channel model.ReleaseChannel, token jwt.Token) (result []model.ImportTypeRelease, err error, code int) {
+	query := url.Values{}
+	if channel != "" {
+		query.Set("channel", string(channel))
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/releases"+encodeQuery(query), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[[]model.ImportTypeRelease](req)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) ListImportTypeRevisions(id string, token jwt.Token, options model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error, errCode int) {
+	queryString := ""
+	query := url.Values{}
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	if len(query) > 0 {
+		queryString = "?" + query.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/revisions"+queryString, nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doWithTotalInResult[[]model.ImportTypeRevision](req)
+}
+
+func (c Client) ReadImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeRevision, err error, errCode int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/revisions/"+strconv.FormatInt(revision, 10), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ImportTypeRevision](req)
+}
+
+// RestoreImportTypeRevision replaces the import type with the snapshot of the revision; breaking changes are rejected with a *model.BreakingChangesError and http.StatusConflict, unless force is set
+func (c Client) RestoreImportTypeRevision(id string, revision int64, force bool, token jwt.Token) (result model.ImportType, err error, errCode int) {
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/revisions/"+strconv.FormatInt(revision, 10)+"/restore"+forceQuery(force), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	result, result.Etag, err, errCode = doWithEtag[model.ImportType](req)
+	return result, err, errCode
+}
+
+// DiffImportTypeRevision lists the changes from the revision to the current version of the import type
+func (c Client) DiffImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeDiff, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/diff?against="+strconv.FormatInt(revision, 10), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ImportTypeDiff](req)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) GetImportTypeConfigsSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int) {
+	return c.getImportTypeSchema(id, "configs", token)
+}
+
+func (c Client) GetImportTypeOutputSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int) {
+	return c.getImportTypeSchema(id, "output", token)
+}
+
+// GetImportTypeAvroSchema returns a model.AvroRecord, model.AvroArray or model.AvroPrimitive as decoded json
+func (c Client) GetImportTypeAvroSchema(id string, token jwt.Token) (result interface{}, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/schema/avro", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[interface{}](req)
+}
+
+func (c Client) GetImportTypeProtoSchema(id string, token jwt.Token) (result string, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/schema/proto", nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doText(req)
+}
+
+func (c Client) CheckImportTypeOutputCompatibility(id string, output model.ContentVariable, token jwt.Token) (result model.OutputCompatibility, err error, code int) {
+	b, err := json.Marshal(output)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/schema/compatibility", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.OutputCompatibility](req)
+}
+
+func (c Client) getImportTypeSchema(id string, kind string, token jwt.Token) (result model.JsonSchema, err error, code int) {
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/schema/"+kind, nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.JsonSchema](req)
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (c Client) ListTrashedImportTypes(token jwt.Token, options model.TrashListOptions) (result []model.TrashedImportType, total int64, err error, code int) {
+	query := url.Values{}
+	if options.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(options.Limit, 10))
+	}
+	if options.Offset != 0 {
+		query.Set("offset", strconv.FormatInt(options.Offset, 10))
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/trash/import-types"+encodeQuery(query), nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doWithTotalInResult[[]model.TrashedImportType](req)
+}
+
+// RestoreTrashedImportType moves the import type back from the trash; breaking changes are rejected with a *model.BreakingChangesError and http.StatusConflict, unless force is set
+func (c Client) RestoreTrashedImportType(id string, force bool, token jwt.Token) (result model.ImportType, err error, code int) {
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/restore"+forceQuery(force), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	result, result.Etag, err, code = doWithEtag[model.ImportType](req)
+	return result, err, code
+}
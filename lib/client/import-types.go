@@ -34,18 +34,71 @@ func (c Client) ReadImportType(id string, token jwt.Token) (result model.ImportT
 		return result, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token.Jwt())
-	return do[model.ImportType](req)
+	result, result.Etag, err, errCode = doWithEtag[model.ImportType](req)
+	return result, err, errCode
 }
 
 func (c Client) ListImportTypes(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportType, total int64, err error, errCode int) {
-	queryString := ""
+	query, err := importTypeListQuery(options)
+	if err != nil {
+		return result, total, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types"+encodeQuery(query), nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doWithTotalInResult[[]model.ImportType](req)
+}
+
+func (c Client) ReadImportTypeExtended(id string, token jwt.Token, resolveReferences bool) (result model.ImportTypeExtended, err error, code int) {
 	query := url.Values{}
+	query.Set("extended", "true")
+	if resolveReferences {
+		query.Set("resolve_references", "true")
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types/"+url.PathEscape(id)+encodeQuery(query), nil)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ImportTypeExtended](req)
+}
+
+func (c Client) ListImportTypesExtended(token jwt.Token, options model.ImportTypeListOptions, resolveReferences bool) (result []model.ImportTypeExtended, total int64, err error, code int) {
+	query, err := importTypeListQuery(options)
+	if err != nil {
+		return result, total, err, http.StatusBadRequest
+	}
+	query.Set("extended", "true")
+	if resolveReferences {
+		query.Set("resolve_references", "true")
+	}
+	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types"+encodeQuery(query), nil)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return doWithTotalInResult[[]model.ImportTypeExtended](req)
+}
+
+func importTypeListQuery(options model.ImportTypeListOptions) (query url.Values, err error) {
+	query = url.Values{}
 	if options.Search != "" {
 		query.Set("search", options.Search)
 	}
+	if options.SearchMode != "" {
+		query.Set("search_mode", string(options.SearchMode))
+	}
 	if options.Ids != nil {
 		query.Set("ids", strings.Join(options.Ids, ","))
 	}
+	if len(options.Tags) > 0 {
+		query.Set("tags", strings.Join(options.Tags, ","))
+	}
+	if len(options.Categories) > 0 {
+		query.Set("categories", strings.Join(options.Categories, ","))
+	}
 	if options.SortBy != "" {
 		query.Set("sort", options.SortBy)
 	}
@@ -55,22 +108,34 @@ func (c Client) ListImportTypes(token jwt.Token, options model.ImportTypeListOpt
 	if options.Offset != 0 {
 		query.Set("offset", strconv.FormatInt(options.Offset, 10))
 	}
+	if options.WithEtag {
+		query.Set("with_etag", "true")
+	}
+	if options.WithoutTotal {
+		query.Set("with_total", "false")
+	}
+	if options.After != nil {
+		cursor, err := model.EncodeImportTypeCursor(*options.After)
+		if err != nil {
+			return query, err
+		}
+		query.Set("cursor", cursor)
+	}
 	if len(options.Criteria) > 0 {
 		filterStr, err := json.Marshal(options.Criteria)
 		if err != nil {
-			return result, total, err, http.StatusBadRequest
+			return query, err
 		}
 		query.Add("criteria", string(filterStr))
 	}
-	if len(query) > 0 {
-		queryString = "?" + query.Encode()
-	}
-	req, err := http.NewRequest(http.MethodGet, c.baseUrl+"/import-types"+queryString, nil)
-	if err != nil {
-		return result, total, err, http.StatusInternalServerError
+	return query, nil
+}
+
+func encodeQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
 	}
-	req.Header.Set("Authorization", token.Jwt())
-	return doWithTotalInResult[[]model.ImportType](req)
+	return "?" + query.Encode()
 }
 
 func (c Client) CreateImportType(importType model.ImportType, token jwt.Token) (result model.ImportType, err error, code int) {
@@ -83,35 +148,115 @@ func (c Client) CreateImportType(importType model.ImportType, token jwt.Token) (
 		return result, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token.Jwt())
+	req.Header.Set("Content-Type", "application/json")
 	return do[model.ImportType](req)
 }
 
+// ValidateImportTypeDraft validates the import type without storing it; findings are part of the result and not returned as error
+func (c Client) ValidateImportTypeDraft(importType model.ImportType, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	b, err := json.Marshal(importType)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types:validate", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ValidationResult](req)
+}
+
+// ValidateImportTypeConfigValues validates config values by config name against the configs of the import type; findings are part of the result and not returned as error
+func (c Client) ValidateImportTypeConfigValues(id string, values map[string]interface{}, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/configs:validate", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	return do[model.ValidationResult](req)
+}
+
+// SetImportType updates the import type; if importType.Etag is set (e.g. by ReadImportType), the update is rejected with http.StatusPreconditionFailed if the import type has been changed in the meantime.
+// breaking changes are rejected with a *model.BreakingChangesError and http.StatusConflict, see SetImportTypeWithOptions.
 func (c Client) SetImportType(importType model.ImportType, token jwt.Token) (err error, code int) {
+	_, err, code = c.SetImportTypeWithOptions(importType, false, token)
+	return err, code
+}
+
+// SetImportTypeWithOptions updates the import type like SetImportType.
+// breaking changes are rejected with a *model.BreakingChangesError and http.StatusConflict, unless force is set or the update bumps the release version.
+// the result is the stored import type; its Etag is set to the new version
+func (c Client) SetImportTypeWithOptions(importType model.ImportType, force bool, token jwt.Token) (result model.ImportType, err error, code int) {
 	b, err := json.Marshal(importType)
 	if err != nil {
-		return err, http.StatusBadRequest
+		return result, err, http.StatusBadRequest
 	}
-	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types", bytes.NewBuffer(b))
+	req, err := http.NewRequest(http.MethodPut, c.baseUrl+"/import-types/"+url.PathEscape(importType.Id)+forceQuery(force), bytes.NewBuffer(b))
 	if err != nil {
-		return err, http.StatusInternalServerError
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	req.Header.Set("Content-Type", "application/json")
+	if importType.Etag != "" {
+		req.Header.Set("If-Match", strconv.Quote(importType.Etag))
+	}
+	result, result.Etag, err, code = doWithEtag[model.ImportType](req)
+	return result, err, code
+}
+
+// PatchImportType applies a model.MergePatch or model.JsonPatch document to the import type; an empty etag skips the version check
+func (c Client) PatchImportType(id string, patchType model.PatchType, patch []byte, etag string, force bool, token jwt.Token) (result model.ImportType, err error, code int) {
+	req, err := http.NewRequest(http.MethodPatch, c.baseUrl+"/import-types/"+url.PathEscape(id)+forceQuery(force), bytes.NewBuffer(patch))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token.Jwt())
-	resp, err := http.DefaultClient.Do(req)
+	req.Header.Set("Content-Type", string(patchType))
+	if etag != "" {
+		req.Header.Set("If-Match", strconv.Quote(etag))
+	}
+	result, result.Etag, err, code = doWithEtag[model.ImportType](req)
+	return result, err, code
+}
+
+// DiffImportType lists the changes an update to the proposed import type would apply
+func (c Client) DiffImportType(id string, proposed model.ImportType, token jwt.Token) (result model.ImportTypeDiff, err error, code int) {
+	b, err := json.Marshal(proposed)
 	if err != nil {
-		return err, http.StatusInternalServerError
+		return result, err, http.StatusBadRequest
 	}
-	return nil, resp.StatusCode
+	req, err := http.NewRequest(http.MethodPost, c.baseUrl+"/import-types/"+url.PathEscape(id)+"/diff", bytes.NewBuffer(b))
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	req.Header.Set("Authorization", token.Jwt())
+	req.Header.Set("Content-Type", "application/json")
+	return do[model.ImportTypeDiff](req)
+}
+
+func forceQuery(force bool) string {
+	if !force {
+		return ""
+	}
+	return "?force=true"
 }
 
 func (c Client) DeleteImportType(id string, token jwt.Token) (err error, errCode int) {
+	return c.DeleteImportTypeIfMatch(id, "", token)
+}
+
+func (c Client) DeleteImportTypeIfMatch(id string, etag string, token jwt.Token) (err error, errCode int) {
 	req, err := http.NewRequest(http.MethodDelete, c.baseUrl+"/import-types/"+id, nil)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
 	req.Header.Set("Authorization", token.Jwt())
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err, http.StatusInternalServerError
+	if etag != "" {
+		req.Header.Set("If-Match", strconv.Quote(etag))
 	}
-	return nil, resp.StatusCode
+	return doVoid(req)
 }
@@ -28,20 +28,35 @@ import (
 )
 
 type Config struct {
-	JwtPubRsa                 string `json:"jwt_pub_rsa"`
-	ServerPort                string `json:"server_port"`
-	KafkaBootstrap            string `json:"kafka_bootstrap"`
-	GroupId                   string `json:"group_id"`
-	DeviceRepoUrl             string `json:"device_repo_url"`
-	MongoUrl                  string `json:"mongo_url"`
-	MongoTable                string `json:"mongo_table"`
-	MongoImportTypeCollection string `json:"mongo_import_type_collection"`
-	Debug                     bool   `json:"debug"`
-	Validate                  bool   `json:"validate"`
-	UsersTopic                string `json:"users_topic"`
-	RepublishStartup          bool   `json:"republish_startup"`
-	PermissionsV2Url          string `json:"permissions_v2_url"`
-	LogHandler                string `json:"log_handler"`
+	JwtPubRsa                         string `json:"jwt_pub_rsa"`
+	ServerPort                        string `json:"server_port"`
+	KafkaBootstrap                    string `json:"kafka_bootstrap"`
+	GroupId                           string `json:"group_id"`
+	DeviceRepoUrl                     string `json:"device_repo_url"`
+	DatabaseBackend                   string `json:"database_backend"`
+	BoltFile                          string `json:"bolt_file"`
+	MongoUrl                          string `json:"mongo_url"`
+	MongoTable                        string `json:"mongo_table"`
+	MongoImportTypeCollection         string `json:"mongo_import_type_collection"`
+	MongoImportTypeRevisionCollection string `json:"mongo_import_type_revision_collection"`
+	MongoImportTypeTrashCollection    string `json:"mongo_import_type_trash_collection"`
+	MongoImportTypeCategoryCollection string `json:"mongo_import_type_category_collection"`
+	MongoReplSet                      bool   `json:"mongo_repl_set"`
+	Debug                             bool   `json:"debug"`
+	Validate                          bool   `json:"validate"`
+	UsersTopic                        string `json:"users_topic"`
+	ImportTypeTopic                   string `json:"import_type_topic"`
+	ConsumerErrorPolicy               string `json:"consumer_error_policy"`
+	ConsumerMaxRetries                int64  `json:"consumer_max_retries"`
+	ConsumerRetryBackoff              string `json:"consumer_retry_backoff"`
+	ConsumerMaxRetryBackoff           string `json:"consumer_max_retry_backoff"`
+	DeadLetterTopic                   string `json:"dead_letter_topic"`
+	DeadLetterFile                    string `json:"dead_letter_file"`
+	RepublishStartup                  bool   `json:"republish_startup"`
+	PermissionsV2Url                  string `json:"permissions_v2_url"`
+	LogHandler                        string `json:"log_handler"`
+	TrashRetention                    string `json:"trash_retention"`
+	TrashPurgeInterval                string `json:"trash_purge_interval"`
 }
 
 // loads config from json in location and used environment variables (e.g ZookeeperUrl --> ZOOKEEPER_URL)
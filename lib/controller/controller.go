@@ -28,11 +28,12 @@ import (
 	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
 )
 
-func New(config config.Config, db database.Database, permV2Client permV2.Client) (ctrl *Controller, err error) {
+func New(config config.Config, db database.Database, permV2Client permV2.Client, producer Producer) (ctrl *Controller, err error) {
 	ctrl = &Controller{
 		db:               db,
 		config:           config,
 		permV2Client:     permV2Client,
+		producer:         producer,
 		deviceRepoClient: deviceRepo.NewClient(config.DeviceRepoUrl, nil),
 	}
 	_, err, _ = ctrl.permV2Client.SetTopic(permV2.InternalAdminToken, permV2.Topic{
@@ -56,6 +57,13 @@ type Controller struct {
 	config           config.Config
 	permV2Client     permV2.Client
 	deviceRepoClient deviceRepo.Interface
+	producer         Producer
+}
+
+// Producer publishes import type changes to interested services
+type Producer interface {
+	PublishImportType(importType model.ImportType) error
+	PublishImportTypeDelete(id string, owner string) error
 }
 
 func getTimeoutContext() (context.Context, context.CancelFunc) {
@@ -99,3 +107,19 @@ func (this *Controller) Migrate() error {
 	}
 	return nil
 }
+
+// RepublishImportTypes publishes all stored import types, e.g. to initialize downstream services
+func (this *Controller) RepublishImportTypes() error {
+	ctx, _ := getTimeoutContext()
+	importTypes, _, err := this.db.ListImportTypes(ctx, model.ImportTypeListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, importType := range importTypes {
+		err = this.producer.PublishImportType(importType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
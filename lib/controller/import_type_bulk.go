@@ -0,0 +1,486 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/hashicorp/go-uuid"
+)
+
+const MaxBulkOperations = 1000
+
+// MaxAtomicBulkOperations limits atomic bulk requests, which are written in one database transaction within atomicBulkTimeout
+const MaxAtomicBulkOperations = 100
+
+// atomicBulkTimeout stays below the transaction lifetime limit of mongodb (transactionLifetimeLimitSeconds, 60s by default)
+const atomicBulkTimeout = 50 * time.Second
+
+var ErrBulkNotExecuted = errors.New("not executed because another operation of the atomic bulk request failed")
+
+type bulkItem struct {
+	operation  model.ImportTypeBulkOperation
+	importType model.ImportType //import type to store on create and update
+	existing   model.ImportType //stored import type on update and delete
+	result     *model.ImportTypeBulkResult
+}
+
+func (this *bulkItem) fail(err error, code int) {
+	this.result.Code = code
+	this.result.Error = err.Error()
+	var validationErr *model.ValidationError
+	if errors.As(err, &validationErr) {
+		this.result.Findings = validationErr.Findings
+	}
+	var breakingErr *model.BreakingChangesError
+	if errors.As(err, &breakingErr) {
+		this.result.Changes = breakingErr.Changes
+	}
+}
+
+func (this *bulkItem) failed() bool {
+	return this.result.Error != ""
+}
+
+// BulkImportTypes executes create, update and delete operations and reports a result per operation.
+// all operations are checked (permissions, preconditions, validation) before anything is written.
+// without atomic, every valid operation is executed on its own.
+// with atomic, all database changes happen in one transaction and nothing is changed if any operation fails;
+// permissions of created import types are set after the commit, which is reverted if they can not be set.
+// the returned code is then the code of the failed operation. atomic requests are limited to MaxAtomicBulkOperations.
+func (this *Controller) BulkImportTypes(operations []model.ImportTypeBulkOperation, atomic bool, token jwt.Token) (result []model.ImportTypeBulkResult, err error, code int) {
+	if len(operations) > MaxBulkOperations {
+		return result, fmt.Errorf("bulk request may contain at most %v operations", MaxBulkOperations), http.StatusBadRequest
+	}
+	if atomic && len(operations) > MaxAtomicBulkOperations {
+		return result, fmt.Errorf("atomic bulk request may contain at most %v operations", MaxAtomicBulkOperations), http.StatusBadRequest
+	}
+	result = make([]model.ImportTypeBulkResult, len(operations))
+	items, err, code := this.prepareBulkItems(operations, token, result)
+	if err != nil {
+		return nil, err, code
+	}
+	if atomic {
+		err, code = this.executeAtomicBulk(items, token)
+		if err != nil {
+			return nil, err, code
+		}
+		return result, nil, code
+	}
+	for _, item := range items {
+		if !item.failed() {
+			this.executeBulkItem(item, token)
+		}
+	}
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) prepareBulkItems(operations []model.ImportTypeBulkOperation, token jwt.Token, result []model.ImportTypeBulkResult) (items []*bulkItem, err error, code int) {
+	writeIds := []string{}
+	administrateIds := []string{}
+	usedIds := map[string]bool{}
+	for i, operation := range operations {
+		item := &bulkItem{operation: operation, result: &result[i]}
+		item.result.Operation = operation.Operation
+		items = append(items, item)
+		switch operation.Operation {
+		case model.BulkCreate:
+			if operation.ImportType == nil {
+				item.fail(errors.New("missing import_type"), http.StatusBadRequest)
+				continue
+			}
+			item.importType = *operation.ImportType
+			if item.importType.Id != "" {
+				item.fail(errors.New("explicit setting of id not allowed"), http.StatusBadRequest)
+				continue
+			}
+			if item.importType.Owner != "" {
+				item.fail(errors.New("explicit setting of owner not allowed"), http.StatusBadRequest)
+				continue
+			}
+			id, err := uuid.GenerateUUID()
+			if err != nil {
+				item.fail(err, http.StatusInternalServerError)
+				continue
+			}
+			item.importType.Id = idPrefix + id
+			item.importType.Owner = token.GetUserId()
+			item.importType.Etag = ""
+			item.result.Id = item.importType.Id
+		case model.BulkUpdate:
+			if operation.ImportType == nil || operation.ImportType.Id == "" {
+				item.fail(errors.New("missing import_type with id"), http.StatusBadRequest)
+				continue
+			}
+			item.importType = *operation.ImportType
+			item.result.Id = item.importType.Id
+			if usedIds[item.result.Id] {
+				item.fail(errors.New("multiple operations for the same import type"), http.StatusBadRequest)
+				continue
+			}
+			usedIds[item.result.Id] = true
+			writeIds = append(writeIds, item.result.Id)
+		case model.BulkDelete:
+			if operation.Id == "" {
+				item.fail(errors.New("missing id"), http.StatusBadRequest)
+				continue
+			}
+			item.result.Id = operation.Id
+			if usedIds[item.result.Id] {
+				item.fail(errors.New("multiple operations for the same import type"), http.StatusBadRequest)
+				continue
+			}
+			usedIds[item.result.Id] = true
+			administrateIds = append(administrateIds, item.result.Id)
+		default:
+			item.fail(fmt.Errorf("unknown operation %q", operation.Operation), http.StatusBadRequest)
+		}
+	}
+
+	// permissions-v2 can not write permissions in batches, but checks can be combined to one request per permission
+	writeAccess, err, code := this.checkMultipleAccess(token, writeIds, permV2Model.Write)
+	if err != nil {
+		return items, err, code
+	}
+	administrateAccess, err, code := this.checkMultipleAccess(token, administrateIds, permV2Model.Administrate)
+	if err != nil {
+		return items, err, code
+	}
+
+	for _, item := range items {
+		if item.failed() {
+			continue
+		}
+		switch item.operation.Operation {
+		case model.BulkUpdate:
+			if !writeAccess[item.result.Id] {
+				item.fail(errors.New("forbidden"), http.StatusForbidden)
+				continue
+			}
+			err, code = this.prepareBulkItemExisting(item, item.importType.Etag)
+			if err != nil {
+				item.fail(err, code)
+				continue
+			}
+			if item.importType.Owner != item.existing.Owner {
+				item.fail(errors.New("transfer of ownership not possible!"), http.StatusBadRequest)
+				continue
+			}
+			item.importType = keepSecretDefaults(item.importType, item.existing, token)
+			item.importType.Etag = ""
+		case model.BulkDelete:
+			if !administrateAccess[item.result.Id] {
+				item.fail(errors.New("forbidden"), http.StatusForbidden)
+				continue
+			}
+			err, code = this.prepareBulkItemExisting(item, item.operation.Etag)
+			if err != nil {
+				item.fail(err, code)
+			}
+			continue
+		}
+		item.importType = model.ResolveReleaseImage(item.importType)
+		err, code = this.checkImportType(token, item.importType)
+		if err != nil {
+			item.fail(err, code)
+			continue
+		}
+		if item.operation.Operation == model.BulkUpdate {
+			err, code = checkBreakingChanges(item.existing, item.importType, item.operation.Force)
+			if err != nil {
+				item.fail(err, code)
+			}
+		}
+	}
+	return items, nil, http.StatusOK
+}
+
+func (this *Controller) prepareBulkItemExisting(item *bulkItem, etag string) (err error, code int) {
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, item.result.Id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return errors.New("not found"), http.StatusNotFound
+	}
+	if etag != "" && etag != existing.Etag {
+		return model.ErrPreconditionFailed, http.StatusPreconditionFailed
+	}
+	item.existing = existing
+	return nil, http.StatusOK
+}
+
+func (this *Controller) checkMultipleAccess(token jwt.Token, ids []string, action permV2Model.Permission) (result map[string]bool, err error, code int) {
+	if len(ids) == 0 {
+		return map[string]bool{}, nil, http.StatusOK
+	}
+	return this.permV2Client.CheckMultiplePermissions(token.Token, PermV2Topic, ids, action)
+}
+
+// executeBulkItem executes a prepared operation like the corresponding single item endpoint
+func (this *Controller) executeBulkItem(item *bulkItem, token jwt.Token) {
+	switch item.operation.Operation {
+	case model.BulkCreate:
+		_, err := this.saveImportType(token, item.importType, "")
+		if err != nil {
+			item.fail(err, http.StatusInternalServerError)
+			return
+		}
+		_, err, code := this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, item.importType.Id, initialPermissions(item.importType.Owner))
+		if err != nil {
+			item.fail(err, code)
+			return
+		}
+		this.publishImportType(item.importType)
+		item.result.Code = http.StatusCreated
+	case model.BulkUpdate:
+		_, err := this.saveImportType(token, item.importType, item.existing.Etag)
+		if errors.Is(err, model.ErrPreconditionFailed) {
+			item.fail(err, http.StatusPreconditionFailed)
+			return
+		}
+		if err != nil {
+			item.fail(err, http.StatusInternalServerError)
+			return
+		}
+		this.publishImportType(item.importType)
+		item.result.Code = http.StatusOK
+	case model.BulkDelete:
+		err, code := this.removeImportType(item.existing, item.existing.Etag, token.GetUserId())
+		if err != nil {
+			item.fail(err, code)
+			return
+		}
+		item.result.Code = http.StatusNoContent
+	}
+}
+
+// executeAtomicBulk executes all prepared operations or none; errors of single operations are reported in their results.
+// the error result is only set if the request could not be executed at all.
+func (this *Controller) executeAtomicBulk(items []*bulkItem, token jwt.Token) (err error, code int) {
+	for _, item := range items {
+		if item.failed() {
+			return nil, abortBulk(items, item.result.Code)
+		}
+	}
+	trashEntries, err, code := this.newBulkTrashEntries(items, token)
+	if err != nil {
+		return err, code
+	}
+	for _, item := range items {
+		if item.failed() {
+			return nil, abortBulk(items, item.result.Code)
+		}
+	}
+	var failedItem *bulkItem
+	var etags map[string]string    //versions stored by the transaction, by import type id
+	var revisions map[string]int64 //revisions recorded by the transaction, by import type id
+	ctx, _ := getAtomicBulkTimeoutContext()
+	err = this.db.Transaction(ctx, func(ctx context.Context) error {
+		// the transaction may be retried; nothing of a previous attempt is kept
+		failedItem = nil
+		etags = map[string]string{}
+		revisions = map[string]int64{}
+		for _, item := range items {
+			var err error
+			switch item.operation.Operation {
+			case model.BulkCreate, model.BulkUpdate:
+				etag := ""
+				if item.operation.Operation == model.BulkUpdate {
+					etag = item.existing.Etag
+				}
+				var stored model.ImportType
+				stored, revisions[item.result.Id], err = this.storeImportType(ctx, token, item.importType, etag)
+				etags[item.result.Id] = stored.Etag
+			case model.BulkDelete:
+				err = this.db.SetTrashedImportType(ctx, trashEntries[item.result.Id])
+				if err == nil {
+					err = this.db.RemoveImportTypeIfMatch(ctx, item.result.Id, item.existing.Etag)
+				}
+			}
+			if err != nil {
+				failedItem = item
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, failAtomicBulk(items, failedItem, err)
+	}
+
+	// permissions-v2 is not part of the transaction: permissions of created import types are set after the commit
+	// and the committed changes are reverted if one of them fails
+	createdPermissions := []string{}
+	for _, item := range items {
+		if item.operation.Operation != model.BulkCreate {
+			continue
+		}
+		_, err, _ = this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, item.importType.Id, initialPermissions(item.importType.Owner))
+		if err != nil {
+			failedItem = item
+			break
+		}
+		createdPermissions = append(createdPermissions, item.importType.Id)
+	}
+	if err != nil {
+		for _, id := range createdPermissions {
+			rollbackErr, _ := this.permV2Client.RemoveResource(client.InternalAdminToken, PermV2Topic, id)
+			if rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("unable to remove permissions of %v: %w", id, rollbackErr))
+			}
+		}
+		rollbackErr := this.revertAtomicBulk(items, etags, revisions)
+		if rollbackErr != nil {
+			err = errors.Join(err, rollbackErr)
+		}
+		return nil, failAtomicBulk(items, failedItem, err)
+	}
+
+	// all changes are committed; errors of the following steps can not be rolled back and are reported per item
+	for _, item := range items {
+		switch item.operation.Operation {
+		case model.BulkCreate, model.BulkUpdate:
+			this.publishImportType(item.importType)
+			item.result.Code = http.StatusOK
+			if item.operation.Operation == model.BulkCreate {
+				item.result.Code = http.StatusCreated
+			}
+		case model.BulkDelete:
+			err, code = this.permV2Client.RemoveResource(client.InternalAdminToken, PermV2Topic, item.result.Id)
+			if err != nil {
+				item.fail(err, code)
+				continue
+			}
+			this.publishImportTypeDelete(item.result.Id, item.existing.Owner)
+			item.result.Code = http.StatusNoContent
+		}
+	}
+	return nil, http.StatusOK
+}
+
+// revertAtomicBulk reverts the committed database changes of an atomic bulk request in one transaction.
+// etags are the versions stored by the bulk request; nothing is reverted if one of the import types has been changed in the meantime.
+// the revisions recorded by the bulk request are removed, so that the history only contains the previous versions.
+func (this *Controller) revertAtomicBulk(items []*bulkItem, etags map[string]string, revisions map[string]int64) error {
+	ctx, _ := getAtomicBulkTimeoutContext()
+	err := this.db.Transaction(ctx, func(ctx context.Context) (err error) {
+		for _, item := range items {
+			id := item.result.Id
+			switch item.operation.Operation {
+			case model.BulkCreate:
+				err = this.db.RemoveImportTypeIfMatch(ctx, id, etags[id])
+				if err == nil {
+					err = this.db.RemoveImportTypeRevisions(ctx, id)
+				}
+			case model.BulkUpdate:
+				existing := item.existing
+				existing.Etag = ""
+				err = this.db.SetImportTypeIfMatch(ctx, existing, etags[id])
+				if err == nil {
+					err = this.db.RemoveImportTypeRevision(ctx, id, revisions[id])
+				}
+			case model.BulkDelete:
+				existing := item.existing
+				existing.Etag = ""
+				err = this.db.SetImportType(ctx, existing)
+				if err == nil {
+					err = this.db.RemoveTrashedImportType(ctx, id)
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("unable to revert %v: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to revert bulk changes: %w", err)
+	}
+	return nil
+}
+
+// failAtomicBulk reports err for the failed operation (the first one, if unknown) and aborts all others
+func failAtomicBulk(items []*bulkItem, failedItem *bulkItem, err error) (code int) {
+	code = http.StatusInternalServerError
+	if errors.Is(err, model.ErrPreconditionFailed) {
+		code = http.StatusPreconditionFailed
+	}
+	if failedItem == nil {
+		failedItem = items[0]
+	}
+	failedItem.fail(err, code)
+	return abortBulk(items, code)
+}
+
+// getAtomicBulkTimeoutContext replaces getTimeoutContext for the transactions of atomic bulk requests
+func getAtomicBulkTimeoutContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), atomicBulkTimeout)
+}
+
+// newBulkTrashEntries creates the trash entries of all delete operations, with one permissions-v2 request.
+// an error is returned if the request fails; delete operations without permissions-v2 resource are failed.
+func (this *Controller) newBulkTrashEntries(items []*bulkItem, token jwt.Token) (result map[string]model.TrashedImportType, err error, code int) {
+	result = map[string]model.TrashedImportType{}
+	ids := []string{}
+	for _, item := range items {
+		if item.operation.Operation == model.BulkDelete {
+			ids = append(ids, item.result.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return result, nil, http.StatusOK
+	}
+	resources, err, code := this.permV2Client.ListResourcesWithAdminPermission(client.InternalAdminToken, PermV2Topic, client.ListOptions{Ids: ids, Limit: int64(len(ids))})
+	if err != nil {
+		return result, err, code
+	}
+	permissions := map[string]permV2Model.ResourcePermissions{}
+	for _, resource := range resources {
+		permissions[resource.Id] = resource.ResourcePermissions
+	}
+	for _, item := range items {
+		if item.operation.Operation != model.BulkDelete {
+			continue
+		}
+		if _, ok := permissions[item.result.Id]; !ok {
+			item.fail(errors.New("permissions not found"), http.StatusNotFound)
+			continue
+		}
+		result[item.result.Id] = newTrashEntryWithPermissions(item.existing, permissions[item.result.Id], token.GetUserId())
+	}
+	return result, nil, http.StatusOK
+}
+
+// abortBulk marks all operations without an error as not executed and returns code
+func abortBulk(items []*bulkItem, code int) int {
+	for _, item := range items {
+		if !item.failed() {
+			item.fail(ErrBulkNotExecuted, http.StatusFailedDependency)
+		}
+	}
+	return code
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ListImportTypeCategories returns the managed category list, which every user may read
+func (this *Controller) ListImportTypeCategories(token jwt.Token) (result []model.ImportTypeCategory, err error, code int) {
+	ctx, _ := getTimeoutContext()
+	result, err = this.db.ListImportTypeCategories(ctx)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
+
+// SetImportTypeCategory creates or updates a category; only admins may manage categories
+func (this *Controller) SetImportTypeCategory(category model.ImportTypeCategory, token jwt.Token) (result model.ImportTypeCategory, err error, code int) {
+	if !token.IsAdmin() {
+		return result, errors.New("only admins may manage categories"), http.StatusForbidden
+	}
+	if strings.TrimSpace(category.Id) == "" {
+		return result, errors.New("missing category id"), http.StatusBadRequest
+	}
+	if strings.TrimSpace(category.Name) == "" {
+		return result, errors.New("missing category name"), http.StatusBadRequest
+	}
+	ctx, _ := getTimeoutContext()
+	err = this.db.SetImportTypeCategory(ctx, category)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return category, nil, http.StatusOK
+}
+
+// DeleteImportTypeCategory removes a category; only admins may manage categories.
+// categories which are still used by import types can not be removed.
+func (this *Controller) DeleteImportTypeCategory(id string, token jwt.Token) (err error, code int) {
+	if !token.IsAdmin() {
+		return errors.New("only admins may manage categories"), http.StatusForbidden
+	}
+	ctx, _ := getTimeoutContext()
+	_, exists, err := this.db.GetImportTypeCategory(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return errors.New("not found"), http.StatusNotFound
+	}
+	used, _, err := this.db.ListImportTypes(ctx, model.ImportTypeListOptions{Categories: []string{id}, Limit: 1, WithoutTotal: true})
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if len(used) > 0 {
+		return errors.New("category is used by import types"), http.StatusConflict
+	}
+	err = this.db.RemoveImportTypeCategory(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	return nil, http.StatusOK
+}
+
+// ListImportTypeTags counts the tags of the import types matching the search, criteria, tags, categories and ids of the options, which the user may read.
+// pagination and sorting options are ignored.
+func (this *Controller) ListImportTypeTags(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error, code int) {
+	if options.SearchMode != "" && options.SearchMode != model.SearchModeText && options.SearchMode != model.SearchModePrefix {
+		return result, errors.New("unknown search_mode " + string(options.SearchMode)), http.StatusBadRequest
+	}
+	options.Ids, err = this.readableImportTypeIds(token, options.Ids)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	ctx, _ := getTimeoutContext()
+	result, err = this.db.ListImportTypeTags(ctx, options)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// CheckImportTypeOutputCompatibility compares a proposed output with the current output of the import type.
+// the proposed output is compatible, if it is able to read the messages written with the current output (backward compatibility)
+func (this *Controller) CheckImportTypeOutputCompatibility(id string, output model.ContentVariable, token jwt.Token) (result model.OutputCompatibility, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	result.Changes = diffContentVariables(importType.Output, output, "/output", backwardCompatible)
+	result.Compatible = !slices.ContainsFunc(result.Changes, func(change model.ImportTypeChange) bool { return change.Breaking })
+	return result, nil, code
+}
+
+// compatibility defines which output schema has to read the messages written with the other one
+type compatibility int
+
+const (
+	// backwardCompatible requires the proposed output to read messages written with the current output
+	backwardCompatible compatibility = iota
+	// forwardCompatible requires consumers of the current output to read messages written with the proposed output
+	forwardCompatible
+)
+
+// diffContentVariables classifies the differences of two content variables. changes are breaking,
+// if the avro or protobuf schema (see model.AvroSchema and model.ProtoSchema) of the reader is not able to read messages of the writer.
+// the reader is the proposed output for backwardCompatible and the current output for forwardCompatible:
+//   - sub content variables missing in the writer (avro fields without default value)
+//   - changed positions of sub content variables (field numbers of the protobuf schema)
+//   - changed types; even integer and float are not interchangeable, because int64 and double use different protobuf wire types
+//   - changes between lists with variable (wildcard) and fixed length
+//
+// sub content variables missing in the reader are skipped by avro and protobuf readers.
+// changed or removed characteristics and changed use_as_tag flags change the meaning of the values and are breaking in both directions.
+// changed names of the root, functions and aspects are compatible.
+func diffContentVariables(current model.ContentVariable, proposed model.ContentVariable, path string, direction compatibility) (changes []model.ImportTypeChange) {
+	changes = []model.ImportTypeChange{}
+	if current.Name != proposed.Name {
+		changes = append(changes, model.ImportTypeChange{Path: path + "/name", Kind: model.ChangeModified, Message: fmt.Sprintf("name changed from %v to %v", current.Name, proposed.Name)})
+	}
+	if current.Type != proposed.Type {
+		return append(changes, model.ImportTypeChange{
+			Path:     path + "/type",
+			Kind:     model.ChangeModified,
+			Breaking: true,
+			Message:  fmt.Sprintf("type of %v changed from %v to %v", proposed.Name, current.Type, proposed.Type),
+		})
+	}
+	if current.CharacteristicId != proposed.CharacteristicId {
+		changes = append(changes, model.ImportTypeChange{
+			Path:     path + "/characteristic_id",
+			Kind:     model.ChangeModified,
+			Breaking: current.CharacteristicId != "",
+			Message:  fmt.Sprintf("characteristic of %v changed from %q to %q", proposed.Name, current.CharacteristicId, proposed.CharacteristicId),
+		})
+	}
+	if current.FunctionId != proposed.FunctionId {
+		changes = append(changes, model.ImportTypeChange{Path: path + "/function_id", Kind: model.ChangeModified, Message: fmt.Sprintf("function of %v changed from %q to %q", proposed.Name, current.FunctionId, proposed.FunctionId)})
+	}
+	if current.AspectId != proposed.AspectId {
+		changes = append(changes, model.ImportTypeChange{Path: path + "/aspect_id", Kind: model.ChangeModified, Message: fmt.Sprintf("aspect of %v changed from %q to %q", proposed.Name, current.AspectId, proposed.AspectId)})
+	}
+	if current.UseAsTag != proposed.UseAsTag {
+		changes = append(changes, model.ImportTypeChange{Path: path + "/use_as_tag", Kind: model.ChangeModified, Breaking: true, Message: fmt.Sprintf("use_as_tag of %v changed to %v", proposed.Name, proposed.UseAsTag)})
+	}
+	if current.Type != model.Structure && current.Type != model.List {
+		return changes
+	}
+	subPath := path + "/sub_content_variables/"
+	if current.Type == model.List && model.IsListWildcard(current) != model.IsListWildcard(proposed) {
+		return append(changes, model.ImportTypeChange{Path: subPath + "0", Kind: model.ChangeModified, Breaking: true, Message: fmt.Sprintf("%v changed between a list of variable length and a list of fixed length", proposed.Name)})
+	}
+	for i, sub := range current.SubContentVariables {
+		j := slices.IndexFunc(proposed.SubContentVariables, func(variable model.ContentVariable) bool { return variable.Name == sub.Name })
+		if j < 0 {
+			changes = append(changes, model.ImportTypeChange{
+				Path:     subPath + strconv.Itoa(i),
+				Kind:     model.ChangeRemoved,
+				Breaking: direction == forwardCompatible,
+				Message:  fmt.Sprintf("%v removed from %v", sub.Name, current.Name),
+			})
+			continue
+		}
+		if i != j {
+			changes = append(changes, model.ImportTypeChange{Path: subPath + strconv.Itoa(j), Kind: model.ChangeModified, Breaking: true, Message: fmt.Sprintf("%v moved from position %v to %v", sub.Name, i, j)})
+		}
+		changes = append(changes, diffContentVariables(sub, proposed.SubContentVariables[j], subPath+strconv.Itoa(j), direction)...)
+	}
+	for j, sub := range proposed.SubContentVariables {
+		if !slices.ContainsFunc(current.SubContentVariables, func(variable model.ContentVariable) bool { return variable.Name == sub.Name }) {
+			changes = append(changes, model.ImportTypeChange{
+				Path:     subPath + strconv.Itoa(j),
+				Kind:     model.ChangeAdded,
+				Breaking: direction == backwardCompatible,
+				Message:  fmt.Sprintf("%v added to %v", sub.Name, proposed.Name),
+			})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestDiffContentVariables(t *testing.T) {
+	current := model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+		{Name: "station", Type: model.String, UseAsTag: true},
+		{Name: "temperature", Type: model.Float, CharacteristicId: "urn:infai:ses:characteristic:celsius"},
+		{Name: "humidity", Type: model.Float},
+		{Name: "history", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "*", Type: model.Float}}},
+	}}
+
+	t.Run("compatible", func(t *testing.T) {
+		proposed := model.ContentVariable{Name: "message", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+			{Name: "station", Type: model.String, UseAsTag: true},
+			{Name: "temperature", Type: model.Float, CharacteristicId: "urn:infai:ses:characteristic:celsius", AspectId: "urn:infai:ses:aspect:air"},
+			{Name: "humidity", Type: model.Float, FunctionId: "urn:infai:ses:measuring-function:humidity"},
+			{Name: "history", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "*", Type: model.Float}}},
+		}}
+		expected := []model.ImportTypeChange{
+			{Path: "/output/name", Kind: model.ChangeModified},
+			{Path: "/output/sub_content_variables/1/aspect_id", Kind: model.ChangeModified},
+			{Path: "/output/sub_content_variables/2/function_id", Kind: model.ChangeModified},
+		}
+		checkChanges(t, diffContentVariables(current, proposed, "/output", backwardCompatible), expected)
+		checkChanges(t, diffContentVariables(current, proposed, "/output", forwardCompatible), expected)
+	})
+
+	t.Run("added sub content variable", func(t *testing.T) {
+		proposed := current
+		proposed.SubContentVariables = append(slices.Clone(current.SubContentVariables), model.ContentVariable{Name: "pressure", Type: model.Float})
+		// the avro field of the proposed schema has no default value for messages of the current schema
+		checkChanges(t, diffContentVariables(current, proposed, "/output", backwardCompatible), []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/4", Kind: model.ChangeAdded, Breaking: true},
+		})
+		// readers of the current schema skip the unknown field
+		checkChanges(t, diffContentVariables(current, proposed, "/output", forwardCompatible), []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/4", Kind: model.ChangeAdded},
+		})
+	})
+
+	t.Run("removed sub content variable", func(t *testing.T) {
+		proposed := current
+		proposed.SubContentVariables = slices.Clone(current.SubContentVariables[:3])
+		checkChanges(t, diffContentVariables(current, proposed, "/output", backwardCompatible), []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/3", Kind: model.ChangeRemoved},
+		})
+		checkChanges(t, diffContentVariables(current, proposed, "/output", forwardCompatible), []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/3", Kind: model.ChangeRemoved, Breaking: true},
+		})
+	})
+
+	t.Run("changed number types", func(t *testing.T) {
+		// int64 (varint) and double (64 bit) use different protobuf wire types, even if avro would promote long to double
+		for name, types := range map[string][2]model.Type{"float to integer": {model.Float, model.Integer}, "integer to float": {model.Integer, model.Float}} {
+			t.Run(name, func(t *testing.T) {
+				from := model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{{Name: "value", Type: types[0]}}}
+				to := model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{{Name: "value", Type: types[1]}}}
+				expected := []model.ImportTypeChange{{Path: "/output/sub_content_variables/0/type", Kind: model.ChangeModified, Breaking: true}}
+				checkChanges(t, diffContentVariables(from, to, "/output", backwardCompatible), expected)
+				checkChanges(t, diffContentVariables(from, to, "/output", forwardCompatible), expected)
+			})
+		}
+	})
+
+	t.Run("breaking", func(t *testing.T) {
+		proposed := model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+			{Name: "station", Type: model.String},
+			{Name: "pressure", Type: model.Float},
+			{Name: "temperature", Type: model.Float, CharacteristicId: "urn:infai:ses:characteristic:kelvin"},
+			{Name: "history", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "*", Type: model.String}}},
+		}}
+		expected := []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/0/use_as_tag", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/output/sub_content_variables/2", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/output/sub_content_variables/2/characteristic_id", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/output/sub_content_variables/2", Kind: model.ChangeRemoved},
+			{Path: "/output/sub_content_variables/3/sub_content_variables/0/type", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/output/sub_content_variables/1", Kind: model.ChangeAdded, Breaking: true},
+		}
+		checkChanges(t, diffContentVariables(current, proposed, "/output", backwardCompatible), expected)
+	})
+
+	t.Run("list length", func(t *testing.T) {
+		proposed := current
+		proposed.SubContentVariables = append([]model.ContentVariable{}, current.SubContentVariables...)
+		proposed.SubContentVariables[3] = model.ContentVariable{Name: "history", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "0", Type: model.Float}}}
+		expected := []model.ImportTypeChange{
+			{Path: "/output/sub_content_variables/3/sub_content_variables/0", Kind: model.ChangeModified, Breaking: true},
+		}
+		checkChanges(t, diffContentVariables(current, proposed, "/output", backwardCompatible), expected)
+	})
+}
+
+func checkChanges(t *testing.T, changes []model.ImportTypeChange, expected []model.ImportTypeChange) {
+	t.Helper()
+	actual := []model.ImportTypeChange{}
+	for _, change := range changes {
+		if change.Message == "" {
+			t.Errorf("missing message in %#v", change)
+		}
+		change.Message = ""
+		actual = append(actual, change)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\n%#v\n%#v", actual, expected)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ValidateImportTypeConfigValues checks config values, e.g. of a planned deployment, against the configs of the import type.
+// values are identified by config name; missing values use the default value.
+// findings are part of the result and not returned as error; their path is a json pointer to the value.
+func (this *Controller) ValidateImportTypeConfigValues(id string, values map[string]interface{}, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Read)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	importType, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	result.Findings = validateConfigValues(importType.Configs, values)
+	result.Valid = len(result.Findings) == 0
+	return result, nil, http.StatusOK
+}
+
+func validateConfigValues(configs []model.ImportConfig, values map[string]interface{}) (findings []model.ValidationFinding) {
+	findings = []model.ValidationFinding{}
+	known := map[string]bool{}
+	for _, conf := range configs {
+		known[conf.Name] = true
+		path := "/" + escapeJsonPointer(conf.Name)
+		value, ok := values[conf.Name]
+		if !ok || value == nil {
+			if conf.Required && conf.DefaultValue == nil {
+				findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationRequired, Message: "missing value for required config " + conf.Name})
+			}
+			continue
+		}
+		if problem := checkConfigValue(conf, value); problem != "" {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationInvalidValue, Message: problem})
+		}
+	}
+	unknown := []string{}
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		findings = append(findings, model.ValidationFinding{Path: "/" + escapeJsonPointer(name), Code: model.ValidationNotAllowed, Message: "unknown config " + name})
+	}
+	return findings
+}
+
+// escapeJsonPointer escapes a reference token of a json pointer (RFC 6901)
+func escapeJsonPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
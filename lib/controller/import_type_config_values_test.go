@@ -0,0 +1,70 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestValidateConfigValues(t *testing.T) {
+	minimum := float64(1)
+	maximum := float64(60)
+	configs := []model.ImportConfig{
+		{Name: "city", Type: model.String, Pattern: "^[A-Z][a-z]+$", Required: true},
+		{Name: "unit", Type: model.String, Enum: []interface{}{"metric", "imperial"}, DefaultValue: "metric"},
+		{Name: "interval", Type: model.Integer, Minimum: &minimum, Maximum: &maximum, DefaultValue: float64(10)},
+		{Name: "api/key", Type: model.String, Required: true, Secret: true},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		findings := validateConfigValues(configs, map[string]interface{}{"city": "Leipzig", "interval": float64(60), "api/key": "secret"})
+		if len(findings) != 0 {
+			t.Errorf("%#v", findings)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		findings := validateConfigValues(configs, map[string]interface{}{"city": "leipzig", "unit": "kelvin", "interval": 1.5, "foo": true})
+		expected := []model.ValidationFinding{
+			{Path: "/city", Code: model.ValidationInvalidValue},
+			{Path: "/unit", Code: model.ValidationInvalidValue},
+			{Path: "/interval", Code: model.ValidationInvalidValue},
+			{Path: "/api~1key", Code: model.ValidationRequired},
+			{Path: "/foo", Code: model.ValidationNotAllowed},
+		}
+		actual := []model.ValidationFinding{}
+		for _, finding := range findings {
+			if finding.Message == "" {
+				t.Errorf("missing message in %#v", finding)
+			}
+			actual = append(actual, model.ValidationFinding{Path: finding.Path, Code: finding.Code})
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("\n%#v\n%#v", actual, expected)
+		}
+	})
+
+	t.Run("range", func(t *testing.T) {
+		findings := validateConfigValues(configs, map[string]interface{}{"city": "Leipzig", "api/key": "secret", "interval": float64(61)})
+		if len(findings) != 1 || findings[0].Path != "/interval" {
+			t.Errorf("%#v", findings)
+		}
+	})
+}
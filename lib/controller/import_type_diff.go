@@ -0,0 +1,237 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// DiffImportType lists the changes an update of the import type to the proposed version would apply
+func (this *Controller) DiffImportType(id string, proposed model.ImportType, token jwt.Token) (result model.ImportTypeDiff, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Read)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	proposed = model.ResolveReleaseImage(keepSecretDefaults(proposed, existing, token))
+	return newImportTypeDiff(diffImportTypes(redactSecrets(existing, token), redactSecrets(proposed, token))), nil, http.StatusOK
+}
+
+// DiffImportTypeRevision lists the changes from the given revision to the current version of the import type
+func (this *Controller) DiffImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeDiff, err error, code int) {
+	old, err, code := this.ReadImportTypeRevision(id, revision, token)
+	if err != nil {
+		return result, err, code
+	}
+	current, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return newImportTypeDiff(diffImportTypes(old.ImportType, current)), nil, http.StatusOK
+}
+
+func newImportTypeDiff(changes []model.ImportTypeChange) model.ImportTypeDiff {
+	return model.ImportTypeDiff{
+		Breaking: slices.ContainsFunc(changes, func(change model.ImportTypeChange) bool { return change.Breaking }),
+		Changes:  changes,
+	}
+}
+
+// checkBreakingChanges rejects updates with breaking changes, unless they are forced or bump the release version
+func checkBreakingChanges(existing model.ImportType, importType model.ImportType, force bool) (err error, code int) {
+	if force || isVersionBump(existing.Releases, importType.Releases) {
+		return nil, http.StatusOK
+	}
+	breaking := []model.ImportTypeChange{}
+	for _, change := range diffImportTypes(existing, importType) {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		}
+	}
+	if len(breaking) > 0 {
+		return &model.BreakingChangesError{Changes: breaking}, http.StatusConflict
+	}
+	return nil, http.StatusOK
+}
+
+// isVersionBump checks if the highest release version of the update has a higher major version (minor version for 0.x)
+// than the highest existing release version; the first release of an import type is a version bump as well
+func isVersionBump(existing []model.ImportTypeRelease, releases []model.ImportTypeRelease) bool {
+	latest, found := latestReleaseVersion(releases)
+	if !found {
+		return false
+	}
+	previous, found := latestReleaseVersion(existing)
+	if !found {
+		return true
+	}
+	if latest.Major == 0 && previous.Major == 0 {
+		return latest.Minor > previous.Minor
+	}
+	return latest.Major > previous.Major
+}
+
+// latestReleaseVersion returns the highest version of all channels; invalid versions are ignored
+func latestReleaseVersion(releases []model.ImportTypeRelease) (result model.Semver, found bool) {
+	for _, release := range releases {
+		version, err := model.ParseSemver(release.Version)
+		if err != nil {
+			continue
+		}
+		if !found || model.CompareSemver(version, result) > 0 {
+			result, found = version, true
+		}
+	}
+	return result, found
+}
+
+// diffImportTypes classifies the changes from current to proposed; changes of the output and configs may be breaking,
+// changes of the name, description, image, default_restart, cost, tags, category and releases are compatible.
+func diffImportTypes(current model.ImportType, proposed model.ImportType) (changes []model.ImportTypeChange) {
+	changes = []model.ImportTypeChange{}
+	fields := []struct {
+		path           string
+		current, value interface{}
+	}{
+		{path: "/name", current: current.Name, value: proposed.Name},
+		{path: "/description", current: current.Description, value: proposed.Description},
+		{path: "/image", current: current.Image, value: proposed.Image},
+		{path: "/default_restart", current: current.DefaultRestart, value: proposed.DefaultRestart},
+		{path: "/cost", current: current.Cost, value: proposed.Cost},
+		{path: "/tags", current: strings.Join(current.Tags, ", "), value: strings.Join(proposed.Tags, ", ")},
+		{path: "/category", current: current.Category, value: proposed.Category},
+	}
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.current, field.value) {
+			changes = append(changes, model.ImportTypeChange{Path: field.path, Kind: model.ChangeModified, Message: fmt.Sprintf("%v changed from %v to %v", field.path[1:], field.current, field.value)})
+		}
+	}
+	for i, release := range proposed.Releases {
+		if !slices.ContainsFunc(current.Releases, func(r model.ImportTypeRelease) bool { return r.Version == release.Version }) {
+			changes = append(changes, model.ImportTypeChange{Path: "/releases/" + strconv.Itoa(i), Kind: model.ChangeAdded, Message: "release " + release.Version + " added"})
+		}
+	}
+	changes = append(changes, diffConfigs(current.Configs, proposed.Configs)...)
+	// running consumers of the import keep reading its messages with the current output
+	return append(changes, diffContentVariables(current.Output, proposed.Output, "/output", forwardCompatible)...)
+}
+
+// diffConfigs matches configs by name. changes are breaking, if config values of running imports may become invalid:
+// removed configs, new required configs without default value, changed types and narrowed constraints.
+func diffConfigs(current []model.ImportConfig, proposed []model.ImportConfig) (changes []model.ImportTypeChange) {
+	changes = []model.ImportTypeChange{}
+	for i, conf := range current {
+		j := slices.IndexFunc(proposed, func(c model.ImportConfig) bool { return c.Name == conf.Name })
+		if j < 0 {
+			changes = append(changes, model.ImportTypeChange{Path: "/configs/" + strconv.Itoa(i), Kind: model.ChangeRemoved, Breaking: true, Message: "config " + conf.Name + " removed"})
+			continue
+		}
+		changes = append(changes, diffConfig(conf, proposed[j], "/configs/"+strconv.Itoa(j))...)
+	}
+	for j, conf := range proposed {
+		if !slices.ContainsFunc(current, func(c model.ImportConfig) bool { return c.Name == conf.Name }) {
+			changes = append(changes, model.ImportTypeChange{Path: "/configs/" + strconv.Itoa(j), Kind: model.ChangeAdded, Breaking: conf.Required && conf.DefaultValue == nil, Message: "config " + conf.Name + " added"})
+		}
+	}
+	return changes
+}
+
+func diffConfig(current model.ImportConfig, proposed model.ImportConfig, path string) (changes []model.ImportTypeChange) {
+	changes = []model.ImportTypeChange{}
+	modified := func(field string, breaking bool, message string) {
+		changes = append(changes, model.ImportTypeChange{Path: path + "/" + field, Kind: model.ChangeModified, Breaking: breaking, Message: message})
+	}
+	if current.Type != proposed.Type {
+		modified("type", true, fmt.Sprintf("type of config %v changed from %v to %v", proposed.Name, current.Type, proposed.Type))
+		return changes
+	}
+	if current.Description != proposed.Description {
+		modified("description", false, "description of config "+proposed.Name+" changed")
+	}
+	if !jsonEqual(current.DefaultValue, proposed.DefaultValue) {
+		message := "default value of config " + proposed.Name + " changed"
+		if !current.Secret && !proposed.Secret {
+			message += fmt.Sprintf(" from %v to %v", current.DefaultValue, proposed.DefaultValue)
+		}
+		modified("default_value", proposed.DefaultValue == nil && proposed.Required, message)
+	}
+	if current.Required != proposed.Required {
+		modified("required", proposed.Required && proposed.DefaultValue == nil, fmt.Sprintf("required of config %v changed to %v", proposed.Name, proposed.Required))
+	}
+	if current.Secret != proposed.Secret {
+		modified("secret", false, fmt.Sprintf("secret of config %v changed to %v", proposed.Name, proposed.Secret))
+	}
+	if !slices.EqualFunc(current.Enum, proposed.Enum, jsonEqual) {
+		narrowed := len(proposed.Enum) > 0 && (len(current.Enum) == 0 || slices.ContainsFunc(current.Enum, func(value interface{}) bool {
+			return !slices.ContainsFunc(proposed.Enum, func(v interface{}) bool { return jsonEqual(v, value) })
+		}))
+		modified("enum", narrowed, fmt.Sprintf("allowed values of config %v changed from %v to %v", proposed.Name, current.Enum, proposed.Enum))
+	}
+	if !reflect.DeepEqual(current.Minimum, proposed.Minimum) {
+		modified("minimum", proposed.Minimum != nil && (current.Minimum == nil || *proposed.Minimum > *current.Minimum), fmt.Sprintf("minimum of config %v changed from %v to %v", proposed.Name, formatBound(current.Minimum), formatBound(proposed.Minimum)))
+	}
+	if !reflect.DeepEqual(current.Maximum, proposed.Maximum) {
+		modified("maximum", proposed.Maximum != nil && (current.Maximum == nil || *proposed.Maximum < *current.Maximum), fmt.Sprintf("maximum of config %v changed from %v to %v", proposed.Name, formatBound(current.Maximum), formatBound(proposed.Maximum)))
+	}
+	if current.Pattern != proposed.Pattern {
+		modified("pattern", proposed.Pattern != "", fmt.Sprintf("pattern of config %v changed from %q to %q", proposed.Name, current.Pattern, proposed.Pattern))
+	}
+	return changes
+}
+
+// jsonEqual compares the json representations of a and b, so that values decoded by the database (e.g. int32 or bson arrays)
+// equal the same values decoded from json (float64 and []interface{})
+func jsonEqual(a interface{}, b interface{}) bool {
+	return reflect.DeepEqual(normalizeJson(a), normalizeJson(b))
+}
+
+func normalizeJson(value interface{}) (result interface{}) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	err = json.Unmarshal(b, &result)
+	if err != nil {
+		return value
+	}
+	return result
+}
+
+func formatBound(bound *float64) string {
+	if bound == nil {
+		return "none"
+	}
+	return strconv.FormatFloat(*bound, 'g', -1, 64)
+}
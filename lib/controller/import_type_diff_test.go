@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDiffConfigs(t *testing.T) {
+	minimum := float64(1)
+	higherMinimum := float64(5)
+	current := []model.ImportConfig{
+		{Name: "interval", Type: model.Integer, DefaultValue: float64(10), Minimum: &minimum},
+		{Name: "unit", Type: model.String, Enum: []interface{}{"s", "m"}},
+		{Name: "city", Type: model.String},
+		{Name: "token", Type: model.String, DefaultValue: "secret", Secret: true},
+	}
+
+	t.Run("compatible", func(t *testing.T) {
+		proposed := []model.ImportConfig{
+			{Name: "unit", Type: model.String, Enum: []interface{}{"s", "m", "h"}},
+			{Name: "interval", Type: model.Integer, DefaultValue: float64(20)},
+			{Name: "city", Type: model.String, Description: "name of the city"},
+			{Name: "token", Type: model.String, DefaultValue: "other", Secret: true},
+			{Name: "debug", Type: model.Boolean, DefaultValue: false, Required: true},
+		}
+		expected := []model.ImportTypeChange{
+			{Path: "/configs/1/default_value", Kind: model.ChangeModified},
+			{Path: "/configs/1/minimum", Kind: model.ChangeModified},
+			{Path: "/configs/0/enum", Kind: model.ChangeModified},
+			{Path: "/configs/2/description", Kind: model.ChangeModified},
+			{Path: "/configs/3/default_value", Kind: model.ChangeModified},
+			{Path: "/configs/4", Kind: model.ChangeAdded},
+		}
+		changes := diffConfigs(current, proposed)
+		checkChanges(t, changes, expected)
+		if changes[4].Message != "default value of config token changed" {
+			t.Error("secret default value in message:", changes[4].Message)
+		}
+	})
+
+	t.Run("breaking", func(t *testing.T) {
+		proposed := []model.ImportConfig{
+			{Name: "interval", Type: model.Float, DefaultValue: float64(10)},
+			{Name: "unit", Type: model.String, Enum: []interface{}{"s"}},
+			{Name: "token", Type: model.String, DefaultValue: "secret", Secret: true, Pattern: "^[a-z]+$"},
+			{Name: "country", Type: model.String, Required: true},
+		}
+		expected := []model.ImportTypeChange{
+			{Path: "/configs/0/type", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/configs/1/enum", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/configs/2", Kind: model.ChangeRemoved, Breaking: true},
+			{Path: "/configs/2/pattern", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/configs/3", Kind: model.ChangeAdded, Breaking: true},
+		}
+		checkChanges(t, diffConfigs(current, proposed), expected)
+	})
+
+	t.Run("narrowed range", func(t *testing.T) {
+		proposed := []model.ImportConfig{
+			{Name: "interval", Type: model.Integer, Minimum: &higherMinimum, Required: true},
+		}
+		expected := []model.ImportTypeChange{
+			{Path: "/configs/0/default_value", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/configs/0/required", Kind: model.ChangeModified, Breaking: true},
+			{Path: "/configs/0/minimum", Kind: model.ChangeModified, Breaking: true},
+		}
+		checkChanges(t, diffConfigs(current[:1], proposed), expected)
+	})
+
+	t.Run("database types", func(t *testing.T) {
+		// values as decoded by the mongo driver compared to the same values decoded from json
+		stored := []model.ImportConfig{
+			{Name: "interval", Type: model.Integer, DefaultValue: int32(10), Enum: []interface{}{int64(10), int64(20)}},
+			{Name: "stations", Type: model.List, DefaultValue: primitive.A{"a", primitive.M{"id": int32(1)}}},
+		}
+		proposed := []model.ImportConfig{
+			{Name: "interval", Type: model.Integer, DefaultValue: float64(10), Enum: []interface{}{float64(10), float64(20)}},
+			{Name: "stations", Type: model.List, DefaultValue: []interface{}{"a", map[string]interface{}{"id": float64(1)}}},
+		}
+		checkChanges(t, diffConfigs(stored, proposed), []model.ImportTypeChange{})
+	})
+}
+
+func TestCheckBreakingChanges(t *testing.T) {
+	existing := model.ImportType{
+		Name:     "test",
+		Output:   model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{{Name: "value", Type: model.Float}}},
+		Releases: []model.ImportTypeRelease{{Version: "1.2.0", Channel: model.ReleaseChannelStable}},
+	}
+	breaking := existing
+	breaking.Output = model.ContentVariable{Name: "output", Type: model.Structure}
+
+	err, code := checkBreakingChanges(existing, breaking, false)
+	var breakingErr *model.BreakingChangesError
+	if code != http.StatusConflict || !errors.As(err, &breakingErr) || len(breakingErr.Changes) != 1 || breakingErr.Changes[0].Path != "/output/sub_content_variables/0" {
+		t.Error(err, code)
+	}
+
+	err, _ = checkBreakingChanges(existing, breaking, true)
+	if err != nil {
+		t.Error(err)
+	}
+
+	breaking.Releases = append(existing.Releases, model.ImportTypeRelease{Version: "1.3.0", Channel: model.ReleaseChannelStable})
+	_, code = checkBreakingChanges(existing, breaking, false)
+	if code != http.StatusConflict {
+		t.Error("minor version bump accepted")
+	}
+
+	breaking.Releases = append(existing.Releases, model.ImportTypeRelease{Version: "2.0.0-beta.1", Channel: model.ReleaseChannelBeta})
+	err, _ = checkBreakingChanges(existing, breaking, false)
+	if err != nil {
+		t.Error(err)
+	}
+
+	compatible := existing
+	compatible.Name = "renamed"
+	err, _ = checkBreakingChanges(existing, compatible, false)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsVersionBump(t *testing.T) {
+	release := func(version string) model.ImportTypeRelease {
+		return model.ImportTypeRelease{Version: version, Channel: model.ReleaseChannelStable}
+	}
+	cases := []struct {
+		existing []model.ImportTypeRelease
+		releases []model.ImportTypeRelease
+		expected bool
+	}{
+		{existing: nil, releases: nil, expected: false},
+		{existing: nil, releases: []model.ImportTypeRelease{release("0.1.0")}, expected: true},
+		{existing: []model.ImportTypeRelease{release("0.1.0")}, releases: []model.ImportTypeRelease{release("0.1.0"), release("0.1.1")}, expected: false},
+		{existing: []model.ImportTypeRelease{release("0.1.0")}, releases: []model.ImportTypeRelease{release("0.1.0"), release("0.2.0")}, expected: true},
+		{existing: []model.ImportTypeRelease{release("1.0.0")}, releases: []model.ImportTypeRelease{release("1.0.0"), release("1.1.0")}, expected: false},
+		{existing: []model.ImportTypeRelease{release("1.0.0")}, releases: []model.ImportTypeRelease{release("1.0.0"), release("2.0.0")}, expected: true},
+	}
+	for i, c := range cases {
+		if actual := isVersionBump(c.existing, c.releases); actual != c.expected {
+			t.Error(i, actual, c.expected)
+		}
+	}
+}
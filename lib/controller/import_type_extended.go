@@ -0,0 +1,185 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"net/http"
+
+	deviceRepo "github.com/SENERGY-Platform/device-repository/lib/client"
+	deviceRepoModel "github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ReadImportTypeExtended returns the import type in its extended form; if resolveReferences is true, the used aspects, functions and characteristics are looked up at the device-repository
+func (this *Controller) ReadImportTypeExtended(id string, token jwt.Token, resolveReferences bool) (result model.ImportTypeExtended, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	list, err, code := this.extendImportTypes([]model.ImportType{importType}, resolveReferences)
+	if err != nil {
+		return result, err, code
+	}
+	return list[0], nil, http.StatusOK
+}
+
+// ListImportTypesExtended works like ListImportTypes but returns the extended form; see ReadImportTypeExtended
+func (this *Controller) ListImportTypesExtended(token jwt.Token, options model.ImportTypeListOptions, resolveReferences bool) (result []model.ImportTypeExtended, total int64, err error, code int) {
+	list, total, err, code := this.ListImportTypes(token, options)
+	if err != nil {
+		return result, total, err, code
+	}
+	result, err, code = this.extendImportTypes(list, resolveReferences)
+	return result, total, err, code
+}
+
+func (this *Controller) extendImportTypes(importTypes []model.ImportType, resolveReferences bool) (result []model.ImportTypeExtended, err error, code int) {
+	var resolver *referenceResolver
+	if resolveReferences {
+		resolver = newReferenceResolver(this.deviceRepoClient)
+		outputs := []model.ContentVariable{}
+		for _, importType := range importTypes {
+			outputs = append(outputs, importType.Output)
+		}
+		err, code = resolver.load(outputs...)
+		if err != nil {
+			return result, err, code
+		}
+	}
+	result = []model.ImportTypeExtended{}
+	for _, importType := range importTypes {
+		extended := model.ExtendImportType(importType)
+		if resolver != nil {
+			references := resolver.references(importType.Output)
+			extended.References = &references
+		}
+		result = append(result, extended)
+	}
+	return result, nil, http.StatusOK
+}
+
+// referenceResolver caches device-repository lookups for the duration of a single request.
+// every id is requested at most once; missing ids are remembered with a nil value.
+type referenceResolver struct {
+	deviceRepoClient deviceRepo.Interface
+	aspects          map[string]*models.AspectNode
+	functions        map[string]*models.Function
+	characteristics  map[string]*models.Characteristic
+}
+
+func newReferenceResolver(deviceRepoClient deviceRepo.Interface) *referenceResolver {
+	return &referenceResolver{
+		deviceRepoClient: deviceRepoClient,
+		aspects:          map[string]*models.AspectNode{},
+		functions:        map[string]*models.Function{},
+		characteristics:  map[string]*models.Characteristic{},
+	}
+}
+
+// load requests all ids used by the variables which are not yet cached, with one request per entity type
+func (this *referenceResolver) load(variables ...model.ContentVariable) (err error, code int) {
+	aspectIds := map[string]bool{}
+	functionIds := map[string]bool{}
+	characteristicIds := map[string]bool{}
+	for _, variable := range variables {
+		walkContentVariable(variable, func(variable model.ContentVariable) {
+			if _, cached := this.aspects[variable.AspectId]; variable.AspectId != "" && !cached {
+				aspectIds[variable.AspectId] = true
+			}
+			if _, cached := this.functions[variable.FunctionId]; variable.FunctionId != "" && !cached {
+				functionIds[variable.FunctionId] = true
+			}
+			if _, cached := this.characteristics[variable.CharacteristicId]; variable.CharacteristicId != "" && !cached {
+				characteristicIds[variable.CharacteristicId] = true
+			}
+		})
+	}
+	if len(aspectIds) > 0 {
+		aspects, err, code := this.deviceRepoClient.GetAspectNodesByIdList(mapKeys(aspectIds))
+		if err != nil {
+			return err, code
+		}
+		for id := range aspectIds {
+			this.aspects[id] = nil
+		}
+		for _, aspect := range aspects {
+			this.aspects[aspect.Id] = &aspect
+		}
+	}
+	if len(functionIds) > 0 {
+		functions, _, err, code := this.deviceRepoClient.ListFunctions(deviceRepoModel.FunctionListOptions{Ids: mapKeys(functionIds), Limit: int64(len(functionIds))})
+		if err != nil {
+			return err, code
+		}
+		for id := range functionIds {
+			this.functions[id] = nil
+		}
+		for _, function := range functions {
+			this.functions[function.Id] = &function
+		}
+	}
+	if len(characteristicIds) > 0 {
+		characteristics, _, err, code := this.deviceRepoClient.ListCharacteristics(deviceRepoModel.CharacteristicListOptions{Ids: mapKeys(characteristicIds), Limit: int64(len(characteristicIds))})
+		if err != nil {
+			return err, code
+		}
+		for id := range characteristicIds {
+			this.characteristics[id] = nil
+		}
+		for _, characteristic := range characteristics {
+			this.characteristics[characteristic.Id] = &characteristic
+		}
+	}
+	return nil, http.StatusOK
+}
+
+// references returns the cached metadata of all ids used by the variable; load has to be called first
+func (this *referenceResolver) references(variable model.ContentVariable) (result model.ImportTypeReferences) {
+	result = model.ImportTypeReferences{
+		Aspects:         map[string]models.AspectNode{},
+		Functions:       map[string]models.Function{},
+		Characteristics: map[string]models.Characteristic{},
+	}
+	walkContentVariable(variable, func(variable model.ContentVariable) {
+		if aspect := this.aspects[variable.AspectId]; aspect != nil {
+			result.Aspects[aspect.Id] = *aspect
+		}
+		if function := this.functions[variable.FunctionId]; function != nil {
+			result.Functions[function.Id] = *function
+		}
+		if characteristic := this.characteristics[variable.CharacteristicId]; characteristic != nil {
+			result.Characteristics[characteristic.Id] = *characteristic
+		}
+	})
+	return result
+}
+
+func walkContentVariable(variable model.ContentVariable, f func(variable model.ContentVariable)) {
+	f(variable)
+	for _, sub := range variable.SubContentVariables {
+		walkContentVariable(sub, f)
+	}
+}
+
+func mapKeys(m map[string]bool) (result []string) {
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	deviceRepo "github.com/SENERGY-Platform/device-repository/lib/client"
+	deviceRepoModel "github.com/SENERGY-Platform/device-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
+type countingDeviceRepo struct {
+	deviceRepo.Interface
+	requests int
+}
+
+func (this *countingDeviceRepo) GetAspectNodesByIdList(ids []string) ([]models.AspectNode, error, int) {
+	this.requests++
+	return this.Interface.GetAspectNodesByIdList(ids)
+}
+
+func (this *countingDeviceRepo) ListFunctions(options deviceRepoModel.FunctionListOptions) ([]models.Function, int64, error, int) {
+	this.requests++
+	return this.Interface.ListFunctions(options)
+}
+
+func (this *countingDeviceRepo) ListCharacteristics(options deviceRepoModel.CharacteristicListOptions) ([]models.Characteristic, int64, error, int) {
+	this.requests++
+	return this.Interface.ListCharacteristics(options)
+}
+
+func TestExtendImportTypes(t *testing.T) {
+	client, db, err := deviceRepo.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	ctx := context.Background()
+	err = db.SetAspectNode(ctx, models.AspectNode{Id: "a1", Name: "aspect", RootId: "a1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = db.SetFunction(ctx, models.Function{Id: "f1", Name: "function", RdfType: models.SES_ONTOLOGY_MEASURING_FUNCTION})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = db.SetCharacteristic(ctx, models.Characteristic{Id: "c1", Name: "characteristic", Type: models.Float})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	counter := &countingDeviceRepo{Interface: client}
+	ctrl := &Controller{deviceRepoClient: counter}
+
+	importTypes := []model.ImportType{}
+	for i := 0; i < 100; i++ {
+		importTypes = append(importTypes, model.ImportType{
+			Id: strconv.Itoa(i),
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "value", Type: model.Float, AspectId: "a1", FunctionId: "f1", CharacteristicId: "c1"},
+					{Name: "unknown", Type: model.Float, CharacteristicId: "unknown"},
+				},
+			},
+		})
+	}
+
+	t.Run("without references", func(t *testing.T) {
+		result, err, _ := ctrl.extendImportTypes(importTypes, false)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(result) != 100 || result[0].References != nil || len(result[0].ContentFunctionIds) != 1 {
+			t.Errorf("%#v", result[0])
+		}
+		if counter.requests != 0 {
+			t.Error(counter.requests)
+		}
+	})
+
+	t.Run("with references", func(t *testing.T) {
+		result, err, _ := ctrl.extendImportTypes(importTypes, true)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if counter.requests != 3 {
+			t.Error(counter.requests)
+		}
+		for _, extended := range result {
+			if extended.References == nil {
+				t.Error("missing references")
+				return
+			}
+			if extended.References.Aspects["a1"].Name != "aspect" ||
+				extended.References.Functions["f1"].Name != "function" ||
+				extended.References.Characteristics["c1"].Name != "characteristic" ||
+				len(extended.References.Characteristics) != 1 {
+				t.Errorf("%#v", extended.References)
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// GetImportTypeFacets counts the import types matching the search, criteria and ids of the options, which the user may read.
+// pagination and sorting options are ignored.
+func (this *Controller) GetImportTypeFacets(token jwt.Token, options model.ImportTypeListOptions) (result model.ImportTypeFacets, err error, code int) {
+	if options.SearchMode != "" && options.SearchMode != model.SearchModeText && options.SearchMode != model.SearchModePrefix {
+		return result, errors.New("unknown search_mode " + string(options.SearchMode)), http.StatusBadRequest
+	}
+	options.Ids, err = this.readableImportTypeIds(token, options.Ids)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	ctx, _ := getTimeoutContext()
+	result, err = this.db.GetImportTypeFacets(ctx, options)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return result, nil, http.StatusOK
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// PatchImportType applies a merge patch (RFC 7396) or json patch (RFC 6902) to the stored import type.
+// the result is handled like a full update with SetImportType; if etag is empty, the version read for the patch is used as precondition.
+// returns the stored import type with the etag of the new version
+func (this *Controller) PatchImportType(id string, patchType model.PatchType, patch []byte, etag string, force bool, token jwt.Token) (result model.ImportType, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Write)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	if etag != "" && etag != existing.Etag {
+		return result, model.ErrPreconditionFailed, http.StatusPreconditionFailed
+	}
+	// the patch is applied to the version the user may read; SetImportType keeps the secret defaults the user does not receive
+	result, err = applyPatch(redactSecrets(existing, token), patchType, patch)
+	if err != nil {
+		return result, err, http.StatusBadRequest
+	}
+	if result.Id != existing.Id {
+		return result, errors.New("change of id not possible"), http.StatusBadRequest
+	}
+	result.Etag = existing.Etag
+	return this.SetImportTypeWithOptions(result, force, token)
+}
+
+func applyPatch(importType model.ImportType, patchType model.PatchType, patch []byte) (result model.ImportType, err error) {
+	importType.Etag = ""
+	original, err := json.Marshal(importType)
+	if err != nil {
+		return result, err
+	}
+	var patched []byte
+	switch patchType {
+	case model.MergePatch:
+		patched, err = jsonpatch.MergePatch(original, patch)
+	case model.JsonPatch:
+		var operations jsonpatch.Patch
+		operations, err = jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return result, err
+		}
+		patched, err = operations.Apply(original)
+	default:
+		return result, fmt.Errorf("unknown patch type %v", patchType)
+	}
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(patched, &result)
+	if err != nil {
+		return result, err
+	}
+	result.Etag = ""
+	return result, nil
+}
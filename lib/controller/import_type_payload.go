@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// GetImportTypeSamplePayload generates a synthetic message matching the output of the import type
+func (this *Controller) GetImportTypeSamplePayload(id string, token jwt.Token) (result interface{}, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return samplePayload(importType.Output), nil, code
+}
+
+// CheckImportTypePayload validates a json decoded message against the output of the import type.
+// findings are part of the result and not returned as error; their path is a json pointer into the payload.
+func (this *Controller) CheckImportTypePayload(id string, payload interface{}, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	result.Findings = checkPayload(importType.Output, payload, "")
+	result.Valid = len(result.Findings) == 0
+	return result, nil, code
+}
+
+func samplePayload(variable model.ContentVariable) interface{} {
+	switch variable.Type {
+	case model.String:
+		return "example"
+	case model.Integer:
+		return 42
+	case model.Float:
+		return 13.37
+	case model.Boolean:
+		return true
+	case model.Structure:
+		result := map[string]interface{}{}
+		for _, sub := range variable.SubContentVariables {
+			result[sub.Name] = samplePayload(sub)
+		}
+		return result
+	case model.List:
+		result := []interface{}{}
+		for _, sub := range variable.SubContentVariables {
+			result = append(result, samplePayload(sub))
+		}
+		return result
+	}
+	return nil
+}
+
+// checkPayload checks the type of the value and of all nested values.
+// all sub content variables of structures are required; lists without a "*" element have a fixed length.
+// values of variables used as tags have to be non-empty strings, numbers or booleans.
+func checkPayload(variable model.ContentVariable, value interface{}, path string) (findings []model.ValidationFinding) {
+	findings = []model.ValidationFinding{}
+	if value == nil {
+		return append(findings, model.ValidationFinding{Path: path, Code: model.ValidationRequired, Message: fmt.Sprintf("missing value for %v", variable.Name)})
+	}
+	if !configValueMatchesType(variable.Type, value) {
+		return append(findings, model.ValidationFinding{Path: path, Code: model.ValidationInvalidType, Message: fmt.Sprintf("value of %v is not a %v", variable.Name, variable.Type)})
+	}
+	if variable.UseAsTag {
+		if str, ok := value.(string); (ok && str == "") || variable.Type == model.Structure || variable.Type == model.List {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationInvalidValue, Message: fmt.Sprintf("value of %v is used as tag and has to be a non-empty string, number or boolean", variable.Name)})
+		}
+	}
+	switch variable.Type {
+	case model.Structure:
+		structure := value.(map[string]interface{})
+		known := map[string]bool{}
+		for _, sub := range variable.SubContentVariables {
+			known[sub.Name] = true
+			findings = append(findings, checkPayload(sub, structure[sub.Name], path+"/"+escapeJsonPointer(sub.Name))...)
+		}
+		unknown := []string{}
+		for name := range structure {
+			if !known[name] {
+				unknown = append(unknown, name)
+			}
+		}
+		slices.Sort(unknown)
+		for _, name := range unknown {
+			findings = append(findings, model.ValidationFinding{Path: path + "/" + escapeJsonPointer(name), Code: model.ValidationNotAllowed, Message: fmt.Sprintf("unknown field %v in %v", name, variable.Name)})
+		}
+	case model.List:
+		list := value.([]interface{})
+		if model.IsListWildcard(variable) {
+			for i, element := range list {
+				findings = append(findings, checkPayload(variable.SubContentVariables[0], element, path+"/"+strconv.Itoa(i))...)
+			}
+			break
+		}
+		if len(variable.SubContentVariables) > 0 && len(list) != len(variable.SubContentVariables) {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationInvalidValue, Message: fmt.Sprintf("%v has %v elements, expected %v", variable.Name, len(list), len(variable.SubContentVariables))})
+		}
+		for i, sub := range variable.SubContentVariables {
+			if i < len(list) {
+				findings = append(findings, checkPayload(sub, list[i], path+"/"+strconv.Itoa(i))...)
+			}
+		}
+	}
+	return findings
+}
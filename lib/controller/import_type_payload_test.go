@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestCheckPayload(t *testing.T) {
+	output := model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+		{Name: "station", Type: model.String, UseAsTag: true},
+		{Name: "value", Type: model.Float},
+		{Name: "count", Type: model.Integer},
+		{Name: "history", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "*", Type: model.Float}}},
+		{Name: "position", Type: model.List, SubContentVariables: []model.ContentVariable{{Name: "0", Type: model.Float}, {Name: "1", Type: model.Float}}},
+		{Name: "meta/data", Type: model.Structure, SubContentVariables: []model.ContentVariable{{Name: "online", Type: model.Boolean}}},
+	}}
+
+	t.Run("sample", func(t *testing.T) {
+		b, err := json.Marshal(samplePayload(output))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		var payload interface{}
+		err = json.Unmarshal(b, &payload)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		findings := checkPayload(output, payload, "")
+		if len(findings) != 0 {
+			t.Errorf("%v %#v", string(b), findings)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var payload interface{}
+		err := json.Unmarshal([]byte(`{"station": "", "value": "1", "count": 1.5, "history": [1, "2"], "position": [1], "meta/data": {}, "foo": 1}`), &payload)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := []model.ValidationFinding{
+			{Path: "/station", Code: model.ValidationInvalidValue},
+			{Path: "/value", Code: model.ValidationInvalidType},
+			{Path: "/count", Code: model.ValidationInvalidType},
+			{Path: "/history/1", Code: model.ValidationInvalidType},
+			{Path: "/position", Code: model.ValidationInvalidValue},
+			{Path: "/meta~1data/online", Code: model.ValidationRequired},
+			{Path: "/foo", Code: model.ValidationNotAllowed},
+		}
+		actual := []model.ValidationFinding{}
+		for _, finding := range checkPayload(output, payload, "") {
+			if finding.Message == "" {
+				t.Errorf("missing message in %#v", finding)
+			}
+			actual = append(actual, model.ValidationFinding{Path: finding.Path, Code: finding.Code})
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("\n%#v\n%#v", actual, expected)
+		}
+	})
+
+	t.Run("root type", func(t *testing.T) {
+		findings := checkPayload(output, []interface{}{}, "")
+		if len(findings) != 1 || findings[0].Path != "" || findings[0].Code != model.ValidationInvalidType {
+			t.Errorf("%#v", findings)
+		}
+	})
+}
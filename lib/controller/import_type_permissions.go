@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// GetImportTypePermissions returns the user, group and role permissions of the import type; requires Administrate
+func (this *Controller) GetImportTypePermissions(id string, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Administrate)
+	if err != nil {
+		return result, err, code
+	}
+	resource, err, code := this.permV2Client.GetResource(client.InternalAdminToken, PermV2Topic, id)
+	if err != nil {
+		return result, err, code
+	}
+	return resource.ResourcePermissions, nil, http.StatusOK
+}
+
+// SetImportTypePermissions replaces the permissions of the import type; requires Administrate.
+// at least one user has to keep the Administrate permission (the invariant DeleteUser relies on) and the admin role always keeps full access.
+func (this *Controller) SetImportTypePermissions(id string, permissions permV2Model.ResourcePermissions, token jwt.Token) (result permV2Model.ResourcePermissions, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Administrate)
+	if err != nil {
+		return result, err, code
+	}
+	if !hasAdministratingUser(permissions) {
+		return result, errors.New("at least one user needs the administrate permission"), http.StatusBadRequest
+	}
+	if permissions.GroupPermissions == nil {
+		permissions.GroupPermissions = map[string]permV2Model.PermissionsMap{}
+	}
+	if permissions.RolePermissions == nil {
+		permissions.RolePermissions = map[string]permV2Model.PermissionsMap{}
+	}
+	permissions.RolePermissions["admin"] = permV2Model.PermissionsMap{
+		Read:         true,
+		Write:        true,
+		Execute:      true,
+		Administrate: true,
+	}
+	return this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, id, permissions)
+}
+
+func hasAdministratingUser(permissions permV2Model.ResourcePermissions) bool {
+	for _, perm := range permissions.UserPermissions {
+		if perm.Administrate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// PublishImportTypeRelease appends the release to the import type; its version has to be higher than all existing versions.
+// the channel defaults to stable and PublishedAt is set to the current time.
+// the result is handled like a full update with SetImportType, using the version read for the update as precondition.
+// returns the stored import type with the etag of the new version
+func (this *Controller) PublishImportTypeRelease(id string, release model.ImportTypeRelease, token jwt.Token) (result model.ImportType, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Write)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	if release.Channel == "" {
+		release.Channel = model.ReleaseChannelStable
+	}
+	release.PublishedAt = time.Now().UTC().Truncate(time.Millisecond)
+	result = existing
+	result.Releases = append(slices.Clone(existing.Releases), release)
+	result = model.ResolveReleaseImage(result)
+	return this.SetImportTypeWithOptions(result, false, token)
+}
+
+// ListImportTypeReleases returns the releases of the import type, newest version first; if channel is not empty, only releases of the channel are returned
+func (this *Controller) ListImportTypeReleases(id string, channel model.ReleaseChannel, token jwt.Token) (result []model.ImportTypeRelease, err error, code int) {
+	if channel != "" && !channel.Valid() {
+		return result, errors.New("unknown release channel " + string(channel)), http.StatusBadRequest
+	}
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	result = []model.ImportTypeRelease{}
+	for _, release := range slices.Backward(importType.Releases) {
+		if channel == "" || release.Channel == channel {
+			result = append(result, release)
+		}
+	}
+	return result, nil, http.StatusOK
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func (this *Controller) ListImportTypeRevisions(id string, token jwt.Token, options model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error, errCode int) {
+	err, code := this.CheckAccessToImportType(token, id, permV2Model.Read)
+	if err != nil {
+		return result, total, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	result, total, err = this.db.ListImportTypeRevisions(ctx, id, options)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	for i, revision := range result {
+		result[i].ImportType = redactSecrets(revision.ImportType, token)
+	}
+	return result, total, nil, http.StatusOK
+}
+
+func (this *Controller) ReadImportTypeRevision(id string, revision int64, token jwt.Token) (result model.ImportTypeRevision, err error, errCode int) {
+	err, code := this.CheckAccessToImportType(token, id, permV2Model.Read)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	result, exists, err := this.db.GetImportTypeRevision(ctx, id, revision)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	result.ImportType = redactSecrets(result.ImportType, token)
+	return result, nil, http.StatusOK
+}
+
+// RestoreImportTypeRevision replaces the current import type with the snapshot of the given revision.
+// the restore is stored as a new revision; the owner of the import type is not changed.
+// the snapshot is checked like an update with SetImportType: restores with breaking changes are rejected, unless force is set.
+func (this *Controller) RestoreImportTypeRevision(id string, revision int64, force bool, token jwt.Token) (result model.ImportType, err error, errCode int) {
+	err, code := this.CheckAccessToImportType(token, id, permV2Model.Write)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	rev, exists, err := this.db.GetImportTypeRevision(ctx, id, revision)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("revision not found"), http.StatusNotFound
+	}
+	result = rev.ImportType
+	result.Id = existing.Id
+	result.Owner = existing.Owner
+	result.Etag = ""
+	result = model.ResolveReleaseImage(result)
+	err, code = this.checkImportType(token, result)
+	if err != nil {
+		return result, err, code
+	}
+	err, code = checkBreakingChanges(existing, result, force)
+	if err != nil {
+		return result, err, code
+	}
+	result, err = this.saveImportType(token, result, existing.Etag)
+	if errors.Is(err, model.ErrPreconditionFailed) {
+		return result, err, http.StatusPreconditionFailed
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	this.publishImportType(result)
+	return redactSecrets(result, token), nil, http.StatusOK
+}
+
+// saveImportType persists the import type and records the new state as revision authored by the token user.
+// if etag is not empty, the import type is only replaced if the stored version matches (model.ErrPreconditionFailed otherwise)
+// the result is the persisted import type including its new etag; the change is published by the caller with publishImportType.
+func (this *Controller) saveImportType(token jwt.Token, importType model.ImportType, etag string) (result model.ImportType, err error) {
+	ctx, _ := getTimeoutContext()
+	result, _, err = this.storeImportType(ctx, token, importType, etag)
+	return result, err
+}
+
+// storeImportType writes the import type and its revision in one transaction without publishing the change.
+// the result is the persisted import type including its new etag, revision is the number of the recorded revision
+func (this *Controller) storeImportType(ctx context.Context, token jwt.Token, importType model.ImportType, etag string) (result model.ImportType, revision int64, err error) {
+	importType.Etag = ""
+	err = this.db.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if etag != "" {
+			err = this.db.SetImportTypeIfMatch(ctx, importType, etag)
+		} else {
+			err = this.db.SetImportType(ctx, importType)
+		}
+		if err != nil {
+			return err
+		}
+		stored, err := this.db.AddImportTypeRevision(ctx, token.GetUserId(), importType)
+		revision = stored.Revision
+		return err
+	})
+	if err != nil {
+		return result, revision, err
+	}
+	result = importType
+	result.Etag, err = model.ImportTypeEtag(importType)
+	return result, revision, err
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// GetImportTypeConfigsSchema returns a json schema of the config values accepted by the import type.
+// the schema is built from the stored import type, so that required configs with secret default values are the same for every user;
+// secret default values are not part of the schema.
+func (this *Controller) GetImportTypeConfigsSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int) {
+	err, code = this.CheckAccessToImportType(token, id, permV2Model.Read)
+	if err != nil {
+		return result, err, code
+	}
+	ctx, _ := getTimeoutContext()
+	importType, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	return model.ConfigsJsonSchema(importType), nil, http.StatusOK
+}
+
+// GetImportTypeOutputSchema returns a json schema of the messages produced by the import type
+func (this *Controller) GetImportTypeOutputSchema(id string, token jwt.Token) (result model.JsonSchema, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return model.OutputJsonSchema(importType), nil, code
+}
+
+// GetImportTypeAvroSchema returns an avro schema of the messages produced by the import type
+func (this *Controller) GetImportTypeAvroSchema(id string, token jwt.Token) (result interface{}, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return model.AvroSchema(importType), nil, code
+}
+
+// GetImportTypeProtoSchema returns a proto3 definition of the messages produced by the import type
+func (this *Controller) GetImportTypeProtoSchema(id string, token jwt.Token) (result string, err error, code int) {
+	importType, err, code := this.ReadImportType(id, token)
+	if err != nil {
+		return result, err, code
+	}
+	return model.ProtoSchema(importType), nil, code
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"slices"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// redactSecrets removes the default values of secret configs, unless the user is the owner of the import type
+func redactSecrets(importType model.ImportType, token jwt.Token) model.ImportType {
+	if token.GetUserId() == importType.Owner {
+		return importType
+	}
+	return model.WithoutSecretDefaults(importType)
+}
+
+func redactSecretsInList(list []model.ImportType, token jwt.Token) []model.ImportType {
+	for i, importType := range list {
+		list[i] = redactSecrets(importType, token)
+	}
+	return list
+}
+
+// keepSecretDefaults restores the default values of secret configs, which are missing in an update by a user other than the owner.
+// such users never receive secret defaults, so a missing default is not a request to remove it.
+func keepSecretDefaults(importType model.ImportType, existing model.ImportType, token jwt.Token) model.ImportType {
+	if token.GetUserId() == existing.Owner {
+		return importType
+	}
+	existingDefaults := map[string]interface{}{}
+	for _, conf := range existing.Configs {
+		if conf.Secret {
+			existingDefaults[conf.Name] = conf.DefaultValue
+		}
+	}
+	importType.Configs = slices.Clone(importType.Configs)
+	for i, conf := range importType.Configs {
+		if existingDefault, ok := existingDefaults[conf.Name]; ok && conf.Secret && conf.DefaultValue == nil {
+			importType.Configs[i].DefaultValue = existingDefault
+		}
+	}
+	return importType
+}
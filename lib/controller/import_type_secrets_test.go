@@ -0,0 +1,68 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	owner := jwt.Token{Sub: "owner"}
+	other := jwt.Token{Sub: "other"}
+	importType := model.ImportType{Owner: "owner", Configs: []model.ImportConfig{
+		{Name: "key", Type: model.String, Secret: true, DefaultValue: "secret"},
+		{Name: "city", Type: model.String, DefaultValue: "Leipzig"},
+	}}
+
+	if result := redactSecrets(importType, owner); result.Configs[0].DefaultValue != "secret" {
+		t.Error("owner should receive secret defaults")
+	}
+	redacted := redactSecrets(importType, other)
+	if redacted.Configs[0].DefaultValue != nil || redacted.Configs[1].DefaultValue != "Leipzig" {
+		t.Errorf("%#v", redacted.Configs)
+	}
+	if importType.Configs[0].DefaultValue != "secret" {
+		t.Error("redaction should not change the original")
+	}
+
+	ownerEtag, err := model.ImportTypeEtag(importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	redactedEtag, err := model.ImportTypeEtag(redacted)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ownerEtag == redactedEtag {
+		t.Error("etag should depend on secret defaults")
+	}
+
+	restored := keepSecretDefaults(redacted, importType, other)
+	if !reflect.DeepEqual(restored, importType) {
+		t.Errorf("%#v", restored)
+	}
+	cleared := keepSecretDefaults(redacted, importType, owner)
+	if cleared.Configs[0].DefaultValue != nil {
+		t.Error("the owner should be able to remove secret defaults")
+	}
+}
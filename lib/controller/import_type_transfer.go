@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// TransferImportTypeOwnership sets a new owner and moves the permissions of the previous owner to the new owner.
+// only admins and the current owner may transfer an import type.
+// the owner is written to the database before the permissions are changed; if the permission update fails, the database change is reverted.
+func (this *Controller) TransferImportTypeOwnership(id string, transfer model.ImportTypeOwnershipTransfer, token jwt.Token) (result model.ImportType, err error, code int) {
+	if transfer.NewOwner == "" {
+		return result, errors.New("missing new_owner"), http.StatusBadRequest
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	if !token.IsAdmin() && token.GetUserId() != existing.Owner {
+		return result, errors.New("only admins and the current owner may transfer an import type"), http.StatusForbidden
+	}
+	if transfer.NewOwner == existing.Owner {
+		return result, errors.New("new_owner is already the owner"), http.StatusBadRequest
+	}
+	resource, err, code := this.permV2Client.GetResource(client.InternalAdminToken, PermV2Topic, id)
+	if err != nil {
+		return result, err, code
+	}
+	permissions := transferredPermissions(resource.ResourcePermissions, existing.Owner, transfer)
+
+	result = existing
+	result.Owner = transfer.NewOwner
+	result.Etag = ""
+	err = this.db.SetImportTypeIfMatch(ctx, result, existing.Etag)
+	if errors.Is(err, model.ErrPreconditionFailed) {
+		return result, err, http.StatusPreconditionFailed
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+
+	_, err, code = this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, id, permissions)
+	if err != nil {
+		etag, etagErr := model.ImportTypeEtag(result)
+		if etagErr != nil {
+			return result, errors.Join(err, fmt.Errorf("unable to revert owner: %w", etagErr)), http.StatusInternalServerError
+		}
+		rollbackErr := this.db.SetImportTypeIfMatch(ctx, existing, etag)
+		if rollbackErr != nil {
+			return result, errors.Join(err, fmt.Errorf("unable to revert owner: %w", rollbackErr)), http.StatusInternalServerError
+		}
+		return result, err, code
+	}
+
+	// the revision is only recorded once the transfer can no longer be reverted
+	_, err = this.db.AddImportTypeRevision(ctx, token.GetUserId(), result)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	this.publishImportType(result)
+	result.Etag, err = model.ImportTypeEtag(result)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return redactSecrets(result, token), nil, http.StatusOK
+}
+
+// transferredPermissions grants the new owner full access and removes the previous owner, who optionally keeps read access
+func transferredPermissions(current permV2Model.ResourcePermissions, previousOwner string, transfer model.ImportTypeOwnershipTransfer) (result permV2Model.ResourcePermissions) {
+	result = permV2Model.ResourcePermissions{
+		UserPermissions:  maps.Clone(current.UserPermissions),
+		GroupPermissions: maps.Clone(current.GroupPermissions),
+		RolePermissions:  maps.Clone(current.RolePermissions),
+	}
+	if result.UserPermissions == nil {
+		result.UserPermissions = map[string]permV2Model.PermissionsMap{}
+	}
+	if result.GroupPermissions == nil {
+		result.GroupPermissions = map[string]permV2Model.PermissionsMap{}
+	}
+	if result.RolePermissions == nil {
+		result.RolePermissions = map[string]permV2Model.PermissionsMap{}
+	}
+	delete(result.UserPermissions, previousOwner)
+	if transfer.KeepReadAccess {
+		result.UserPermissions[previousOwner] = permV2Model.PermissionsMap{Read: true}
+	}
+	result.UserPermissions[transfer.NewOwner] = permV2Model.PermissionsMap{
+		Read:         true,
+		Write:        true,
+		Execute:      true,
+		Administrate: true,
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+func TestTransferredPermissions(t *testing.T) {
+	current := initialPermissions("old")
+	current.UserPermissions["reader"] = permV2Model.PermissionsMap{Read: true}
+
+	full := permV2Model.PermissionsMap{Read: true, Write: true, Execute: true, Administrate: true}
+
+	result := transferredPermissions(current, "old", model.ImportTypeOwnershipTransfer{NewOwner: "new"})
+	expected := map[string]permV2Model.PermissionsMap{"new": full, "reader": {Read: true}}
+	if !reflect.DeepEqual(result.UserPermissions, expected) {
+		t.Errorf("%#v", result.UserPermissions)
+	}
+	if !result.RolePermissions["admin"].Administrate {
+		t.Errorf("%#v", result.RolePermissions)
+	}
+	if _, ok := current.UserPermissions["new"]; ok {
+		t.Error("current permissions have been modified")
+	}
+
+	result = transferredPermissions(current, "old", model.ImportTypeOwnershipTransfer{NewOwner: "new", KeepReadAccess: true})
+	expected = map[string]permV2Model.PermissionsMap{"new": full, "reader": {Read: true}, "old": {Read: true}}
+	if !reflect.DeepEqual(result.UserPermissions, expected) {
+		t.Errorf("%#v", result.UserPermissions)
+	}
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+// ListTrashedImportTypes lists deleted import types; non admins only see entries they were allowed to administrate
+func (this *Controller) ListTrashedImportTypes(token jwt.Token, options model.TrashListOptions) (result []model.TrashedImportType, total int64, err error, code int) {
+	options.Administrator = nil
+	if !token.IsAdmin() {
+		options.Administrator = &model.TrashAdministrator{
+			UserId: token.GetUserId(),
+			Groups: token.GetGroups(),
+			Roles:  token.GetRoles(),
+		}
+	}
+	ctx, _ := getTimeoutContext()
+	result, total, err = this.db.ListTrashedImportTypes(ctx, options)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
+	for i, trashed := range result {
+		result[i].ImportType = redactSecrets(trashed.ImportType, token)
+	}
+	return result, total, nil, http.StatusOK
+}
+
+// RestoreTrashedImportType moves a deleted import type back from the trash and restores the permissions it had when it was deleted.
+// the restore is recorded as a new revision. the import type is validated like an update with SetImportType and compared with its
+// latest revision; restores with breaking changes are rejected, unless force is set.
+func (this *Controller) RestoreTrashedImportType(id string, force bool, token jwt.Token) (result model.ImportType, err error, code int) {
+	ctx, _ := getTimeoutContext()
+	trashed, exists, err := this.db.GetTrashedImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if !exists {
+		return result, errors.New("not found"), http.StatusNotFound
+	}
+	if !token.IsAdmin() && !canAdministrate(trashed.Permissions, token) {
+		return result, errors.New("forbidden"), http.StatusForbidden
+	}
+	_, exists, err = this.db.GetImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if exists {
+		return result, errors.New("import type already exists"), http.StatusConflict
+	}
+	result = trashed.ImportType
+	result.Etag = ""
+	result = model.ResolveReleaseImage(result)
+	err, code = this.checkImportType(token, result)
+	if err != nil {
+		return result, err, code
+	}
+	latest, _, err := this.db.ListImportTypeRevisions(ctx, id, model.ImportTypeRevisionListOptions{Limit: 1})
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	if len(latest) > 0 {
+		err, code = checkBreakingChanges(latest[0].ImportType, result, force)
+		if err != nil {
+			return result, err, code
+		}
+	}
+	err = this.db.SetImportType(ctx, result)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	_, err, code = this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, id, trashed.Permissions)
+	if err != nil {
+		rollbackErr := this.db.RemoveImportType(ctx, id)
+		if rollbackErr != nil {
+			err = errors.Join(err, fmt.Errorf("unable to remove restored import type: %w", rollbackErr))
+		}
+		return result, err, code
+	}
+	err = this.db.RemoveTrashedImportType(ctx, id)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	_, err = this.db.AddImportTypeRevision(ctx, token.GetUserId(), result)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	this.publishImportType(result)
+	result.Etag, err = model.ImportTypeEtag(result)
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	return redactSecrets(result, token), nil, http.StatusOK
+}
+
+// PurgeTrash permanently removes all trash entries, and the revisions of their import types, deleted before the given time
+func (this *Controller) PurgeTrash(deletedBefore time.Time) error {
+	for {
+		ctx, _ := getTimeoutContext()
+		list, _, err := this.db.ListTrashedImportTypes(ctx, model.TrashListOptions{Limit: 100, DeletedBefore: deletedBefore})
+		if err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, trashed := range list {
+			err = this.db.RemoveImportTypeRevisions(ctx, trashed.ImportType.Id)
+			if err != nil {
+				return err
+			}
+			err = this.db.RemoveTrashedImportType(ctx, trashed.ImportType.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StartTrashPurger calls PurgeTrash every interval for entries older than retention, until ctx is done
+func (this *Controller) StartTrashPurger(ctx context.Context, wg *sync.WaitGroup, retention time.Duration, interval time.Duration) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := this.PurgeTrash(time.Now().Add(-retention))
+				if err != nil {
+					log.Logger.Error("unable to purge trash", attributes.ErrorKey, err)
+				}
+			}
+		}
+	}()
+}
+
+func (this *Controller) newTrashEntry(importType model.ImportType, deletedBy string) (result model.TrashedImportType, err error, code int) {
+	resource, err, code := this.permV2Client.GetResource(client.InternalAdminToken, PermV2Topic, importType.Id)
+	if err != nil {
+		return result, err, code
+	}
+	return newTrashEntryWithPermissions(importType, resource.ResourcePermissions, deletedBy), nil, http.StatusOK
+}
+
+func newTrashEntryWithPermissions(importType model.ImportType, permissions permV2Model.ResourcePermissions, deletedBy string) model.TrashedImportType {
+	importType.Etag = ""
+	return model.TrashedImportType{
+		ImportType:  importType,
+		Permissions: permissions,
+		DeletedBy:   deletedBy,
+		DeletedAt:   time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// canAdministrate checks the permissions for an administrate right of the token user, one of its groups or one of its roles
+func canAdministrate(permissions permV2Model.ResourcePermissions, token jwt.Token) bool {
+	if permissions.UserPermissions[token.GetUserId()].Administrate {
+		return true
+	}
+	for group, perm := range permissions.GroupPermissions {
+		if perm.Administrate && slices.Contains(token.GetGroups(), group) {
+			return true
+		}
+	}
+	for role, perm := range permissions.RolePermissions {
+		if perm.Administrate && slices.Contains(token.GetRoles(), role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func TestCanAdministrate(t *testing.T) {
+	permissions := permV2Model.ResourcePermissions{
+		UserPermissions:  map[string]permV2Model.PermissionsMap{"owner": {Read: true, Administrate: true}, "reader": {Read: true}},
+		GroupPermissions: map[string]permV2Model.PermissionsMap{"/admins": {Administrate: true}},
+		RolePermissions:  map[string]permV2Model.PermissionsMap{"admin": {Administrate: true}, "user": {Read: true}},
+	}
+	for name, test := range map[string]struct {
+		token    jwt.Token
+		expected bool
+	}{
+		"owner":   {token: jwt.Token{Sub: "owner"}, expected: true},
+		"reader":  {token: jwt.Token{Sub: "reader", RealmAccess: map[string][]string{"roles": {"user"}}}, expected: false},
+		"group":   {token: jwt.Token{Sub: "other", Groups: []string{"/admins"}}, expected: true},
+		"role":    {token: jwt.Token{Sub: "other", RealmAccess: map[string][]string{"roles": {"admin"}}}, expected: true},
+		"unknown": {token: jwt.Token{Sub: "other"}, expected: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := canAdministrate(permissions, test.token); actual != test.expected {
+				t.Error(actual)
+			}
+		})
+	}
+}
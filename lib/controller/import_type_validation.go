@@ -17,153 +17,312 @@
 package controller
 
 import (
-	"errors"
-	"github.com/SENERGY-Platform/import-repository/lib/model"
-	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"fmt"
 	"math"
 	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+	"github.com/distribution/reference"
 )
 
+// ValidateImportType returns a *model.ValidationError with all findings if the import type is invalid.
+// the code is http.StatusInternalServerError if referenced characteristics, functions, aspects or categories could not be checked.
 func (this *Controller) ValidateImportType(token jwt.Token, importType model.ImportType) (err error, code int) {
+	return validationFindingsError(this.validateImportType(token, importType))
+}
+
+// checkImportType validates the import type before it is stored: completely if config.Validate is set, otherwise only tags, category and releases
+func (this *Controller) checkImportType(token jwt.Token, importType model.ImportType) (err error, code int) {
+	if this.config.Validate {
+		return this.ValidateImportType(token, importType)
+	}
+	return validationFindingsError(append(this.validateClassification(importType), validateReleases(importType.Releases)...))
+}
+
+func validationFindingsError(findings []model.ValidationFinding) (err error, code int) {
+	if len(findings) == 0 {
+		return nil, http.StatusOK
+	}
+	code = http.StatusBadRequest
+	for _, finding := range findings {
+		if finding.Code == model.ValidationReferenceCheckFailed {
+			code = http.StatusInternalServerError
+		}
+	}
+	return &model.ValidationError{Findings: findings}, code
+}
+
+// ValidateImportTypeDraft validates the import type regardless of config.Validate and never stores anything.
+// findings are part of the result and not returned as error.
+func (this *Controller) ValidateImportTypeDraft(importType model.ImportType, token jwt.Token) (result model.ValidationResult, err error, code int) {
+	result.Findings = this.validateImportType(token, importType)
+	if result.Findings == nil {
+		result.Findings = []model.ValidationFinding{}
+	}
+	result.Valid = len(result.Findings) == 0
+	return result, nil, http.StatusOK
+}
+
+func (this *Controller) validateImportType(token jwt.Token, importType model.ImportType) (findings []model.ValidationFinding) {
 	if len(importType.Name) == 0 {
-		return errors.New("name might not be empty"), http.StatusBadRequest
+		findings = append(findings, model.ValidationFinding{Path: "/name", Code: model.ValidationRequired, Message: "name might not be empty"})
 	}
 
 	if len(importType.Image) == 0 {
-		return errors.New("image might not be empty"), http.StatusBadRequest
+		findings = append(findings, model.ValidationFinding{Path: "/image", Code: model.ValidationRequired, Message: "image might not be empty"})
 	}
 
 	confNames := []string{}
-	for _, conf := range importType.Configs {
-		if contains(confNames, conf.Name) {
-			return errors.New("duplicate config name"), http.StatusBadRequest
+	for i, conf := range importType.Configs {
+		path := "/configs/" + strconv.Itoa(i)
+		if len(conf.Name) > 0 && contains(confNames, conf.Name) {
+			findings = append(findings, model.ValidationFinding{Path: path + "/name", Code: model.ValidationDuplicate, Message: "duplicate config name " + conf.Name})
 		}
 		confNames = append(confNames, conf.Name)
-		if !validateConfig(conf) {
-			return errors.New("invalid config"), http.StatusBadRequest
+		findings = append(findings, validateConfig(conf, path)...)
+	}
+
+	references := []contentVariableReference{}
+	findings = append(findings, validateContentVariableStep(importType.Output, "/output", &references)...)
+	findings = append(findings, this.validateContentVariableReferences(references)...)
+	findings = append(findings, this.validateClassification(importType)...)
+	findings = append(findings, validateReleases(importType.Releases)...)
+	return findings
+}
+
+// validateReleases checks the format of each release and that the versions are strictly ascending
+func validateReleases(releases []model.ImportTypeRelease) (findings []model.ValidationFinding) {
+	var previous *model.Semver
+	for i, release := range releases {
+		path := "/releases/" + strconv.Itoa(i)
+		version, err := model.ParseSemver(release.Version)
+		if err != nil {
+			findings = append(findings, model.ValidationFinding{Path: path + "/version", Code: model.ValidationInvalidFormat, Message: err.Error()})
+		} else {
+			if previous != nil {
+				switch model.CompareSemver(version, *previous) {
+				case 0:
+					findings = append(findings, model.ValidationFinding{Path: path + "/version", Code: model.ValidationDuplicate, Message: "duplicate release version " + release.Version})
+				case -1:
+					findings = append(findings, model.ValidationFinding{Path: path + "/version", Code: model.ValidationInvalidOrder, Message: "release version " + release.Version + " is lower than the version of the previous release"})
+				}
+			}
+			previous = &version
+		}
+		if release.Image == "" {
+			findings = append(findings, model.ValidationFinding{Path: path + "/image", Code: model.ValidationRequired, Message: "release image might not be empty"})
+		} else if _, err = reference.ParseNormalizedNamed(release.Image); err != nil {
+			findings = append(findings, model.ValidationFinding{Path: path + "/image", Code: model.ValidationInvalidFormat, Message: fmt.Sprintf("invalid image reference %q: %v", release.Image, err)})
+		}
+		if release.Channel == "" {
+			findings = append(findings, model.ValidationFinding{Path: path + "/channel", Code: model.ValidationRequired, Message: "release channel might not be empty"})
+		} else if !release.Channel.Valid() {
+			findings = append(findings, model.ValidationFinding{Path: path + "/channel", Code: model.ValidationInvalidFormat, Message: fmt.Sprintf("unknown release channel %q", release.Channel)})
 		}
 	}
+	return findings
+}
 
-	ok, err := this.validateContentVariable(token, importType.Output)
-	if err != nil {
-		return err, http.StatusInternalServerError
+// validateClassification checks the tags and that the category exists in the managed category list
+func (this *Controller) validateClassification(importType model.ImportType) (findings []model.ValidationFinding) {
+	tags := []string{}
+	for i, tag := range importType.Tags {
+		path := "/tags/" + strconv.Itoa(i)
+		if strings.TrimSpace(tag) == "" {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationRequired, Message: "tag might not be empty"})
+		} else if strings.Contains(tag, ",") {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationNotAllowed, Message: "tag might not contain ','"})
+		} else if contains(tags, tag) {
+			findings = append(findings, model.ValidationFinding{Path: path, Code: model.ValidationDuplicate, Message: "duplicate tag " + tag})
+		}
+		tags = append(tags, tag)
 	}
-	if !ok {
-		return errors.New("invalid output"), http.StatusBadRequest
+	if importType.Category != "" {
+		ctx, _ := getTimeoutContext()
+		_, exists, err := this.db.GetImportTypeCategory(ctx, importType.Category)
+		if err != nil {
+			findings = append(findings, model.ValidationFinding{Path: "/category", Code: model.ValidationReferenceCheckFailed, Message: fmt.Sprintf("unable to check category %v: %v", importType.Category, err)})
+		} else if !exists {
+			findings = append(findings, model.ValidationFinding{Path: "/category", Code: model.ValidationUnknownReference, Message: "unknown category " + importType.Category})
+		}
 	}
-	return
+	return findings
 }
 
-func validateConfig(conf model.ImportConfig) (valid bool) {
-	valid = true
-	if len(conf.Name) == 0 ||
-		(conf.Type != model.String &&
-			conf.Type != model.Integer &&
-			conf.Type != model.Float &&
-			conf.Type != model.List &&
-			conf.Type != model.Structure &&
-			conf.Type != model.Boolean) {
-		return false
+func validateConfig(conf model.ImportConfig, path string) (findings []model.ValidationFinding) {
+	if len(conf.Name) == 0 {
+		findings = append(findings, model.ValidationFinding{Path: path + "/name", Code: model.ValidationRequired, Message: "config name might not be empty"})
 	}
-	if conf.DefaultValue != nil {
-		switch conf.Type {
-		case model.String:
-			_, valid = conf.DefaultValue.(string)
-			break
-		case model.Integer:
-			val, validInner := conf.DefaultValue.(float64)
-			valid = validInner && math.Mod(val, 1) == 0
-			break
-		case model.Float:
-			_, valid = conf.DefaultValue.(float64)
-			break
-		case model.List:
-			_, valid = conf.DefaultValue.([]interface{})
-			break
-		case model.Structure:
-			_, valid = conf.DefaultValue.(map[string]interface{})
-			break
-		case model.Boolean:
-			_, valid = conf.DefaultValue.(bool)
-			break
-		}
-	}
-	return valid
-}
-
-func (this *Controller) validateContentVariable(token jwt.Token, variable model.ContentVariable) (valid bool, err error) {
-	valid, characteristicIds, functionIds, aspectIds := this.validateContentVariableStep(token, variable)
-	if !valid {
-		return false, nil
-	}
-	if len(characteristicIds) > 0 {
-		for _, characteristicId := range characteristicIds {
-			_, err, code := this.deviceRepoClient.GetCharacteristic(characteristicId)
-			if err != nil || code > 299 {
-				return false, err
+	if !isValidType(conf.Type) {
+		return append(findings, model.ValidationFinding{Path: path + "/type", Code: model.ValidationInvalidType, Message: fmt.Sprintf("unknown config type %q", conf.Type)})
+	}
+	numeric := conf.Type == model.Integer || conf.Type == model.Float
+	if len(conf.Enum) > 0 && !numeric && conf.Type != model.String {
+		findings = append(findings, model.ValidationFinding{Path: path + "/enum", Code: model.ValidationNotAllowed, Message: "enum is only allowed for strings, integers and floats"})
+	} else {
+		for i, value := range conf.Enum {
+			if !configValueMatchesType(conf.Type, value) {
+				findings = append(findings, model.ValidationFinding{Path: path + "/enum/" + strconv.Itoa(i), Code: model.ValidationInvalidValue, Message: "enum value does not match config type " + string(conf.Type)})
 			}
 		}
 	}
-	if len(functionIds) > 0 {
-		for _, functionId := range functionIds {
-			_, err, code := this.deviceRepoClient.GetFunction(functionId)
-			if err != nil || code > 299 {
-				return false, err
-			}
+	if conf.Minimum != nil && !numeric {
+		findings = append(findings, model.ValidationFinding{Path: path + "/minimum", Code: model.ValidationNotAllowed, Message: "minimum is only allowed for integers and floats"})
+	}
+	if conf.Maximum != nil && !numeric {
+		findings = append(findings, model.ValidationFinding{Path: path + "/maximum", Code: model.ValidationNotAllowed, Message: "maximum is only allowed for integers and floats"})
+	}
+	if numeric && conf.Minimum != nil && conf.Maximum != nil && *conf.Minimum > *conf.Maximum {
+		findings = append(findings, model.ValidationFinding{Path: path + "/maximum", Code: model.ValidationInvalidRange, Message: "maximum is lower than minimum"})
+	}
+	if conf.Pattern != "" {
+		if conf.Type != model.String {
+			findings = append(findings, model.ValidationFinding{Path: path + "/pattern", Code: model.ValidationNotAllowed, Message: "pattern is only allowed for strings"})
+		} else if _, err := regexp.Compile(conf.Pattern); err != nil {
+			findings = append(findings, model.ValidationFinding{Path: path + "/pattern", Code: model.ValidationInvalidFormat, Message: "invalid pattern: " + err.Error()})
 		}
 	}
-	if len(aspectIds) > 0 {
-		uniqueIds := []string{}
-		for _, id := range aspectIds {
-			if !contains(uniqueIds, id) {
-				uniqueIds = append(uniqueIds, id)
-			}
+	if conf.DefaultValue != nil {
+		if problem := checkConfigValue(conf, conf.DefaultValue); problem != "" {
+			findings = append(findings, model.ValidationFinding{Path: path + "/default_value", Code: model.ValidationInvalidDefaultValue, Message: "default " + problem})
 		}
+	}
+	return findings
+}
 
-		for _, aspectId := range uniqueIds {
-			_, err, code := this.deviceRepoClient.GetAspectNode(aspectId)
-			if err != nil || code > 299 {
-				return false, err
-			}
+// checkConfigValue returns a description of the first violated constraint of the config, or an empty string if the value is valid
+func checkConfigValue(conf model.ImportConfig, value interface{}) (problem string) {
+	if !configValueMatchesType(conf.Type, value) {
+		return "value does not match config type " + string(conf.Type)
+	}
+	if len(conf.Enum) > 0 && !slices.ContainsFunc(conf.Enum, func(allowed interface{}) bool { return reflect.DeepEqual(allowed, value) }) {
+		return fmt.Sprintf("value %v is not one of the allowed values %v", value, conf.Enum)
+	}
+	if number, ok := value.(float64); ok {
+		if conf.Minimum != nil && number < *conf.Minimum {
+			return fmt.Sprintf("value %v is lower than the minimum %v", number, *conf.Minimum)
+		}
+		if conf.Maximum != nil && number > *conf.Maximum {
+			return fmt.Sprintf("value %v is higher than the maximum %v", number, *conf.Maximum)
 		}
 	}
-	return valid, err
+	if str, ok := value.(string); ok && conf.Pattern != "" {
+		// invalid patterns are reported by validateConfig
+		if pattern, err := regexp.Compile(conf.Pattern); err == nil && !pattern.MatchString(str) {
+			return fmt.Sprintf("value %q does not match the pattern %v", str, conf.Pattern)
+		}
+	}
+	return ""
 }
 
-func (this *Controller) validateContentVariableStep(token jwt.Token, variable model.ContentVariable) (valid bool, characteristicIds []string, functionIds []string, aspectIds []string) {
-	if len(variable.Name) == 0 || len(variable.Type) == 0 {
-		return false, characteristicIds, functionIds, aspectIds
+// configValueMatchesType checks the type of a json decoded value
+func configValueMatchesType(t model.Type, value interface{}) bool {
+	switch t {
+	case model.String:
+		_, ok := value.(string)
+		return ok
+	case model.Integer:
+		val, ok := value.(float64)
+		return ok && math.Mod(val, 1) == 0
+	case model.Float:
+		_, ok := value.(float64)
+		return ok
+	case model.List:
+		_, ok := value.([]interface{})
+		return ok
+	case model.Structure:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case model.Boolean:
+		_, ok := value.(bool)
+		return ok
 	}
-	if variable.Type != model.String &&
-		variable.Type != model.Integer &&
-		variable.Type != model.Float &&
-		variable.Type != model.List &&
-		variable.Type != model.Structure &&
-		variable.Type != model.Boolean {
-		return false, characteristicIds, functionIds, aspectIds
+	return false
+}
+
+func isValidType(t model.Type) bool {
+	return t == model.String ||
+		t == model.Integer ||
+		t == model.Float ||
+		t == model.List ||
+		t == model.Structure ||
+		t == model.Boolean
+}
+
+// contentVariableReference is a characteristic, function or aspect id used by a content variable
+type contentVariableReference struct {
+	kind string
+	id   string
+	path string
+}
+
+func validateContentVariableStep(variable model.ContentVariable, path string, references *[]contentVariableReference) (findings []model.ValidationFinding) {
+	if len(variable.Name) == 0 {
+		findings = append(findings, model.ValidationFinding{Path: path + "/name", Code: model.ValidationRequired, Message: "content variable name might not be empty"})
 	}
-	if variable.Type != model.Structure && variable.Type != model.List && len(variable.SubContentVariables) > 0 {
-		return false, characteristicIds, functionIds, aspectIds
+	if len(variable.Type) == 0 {
+		findings = append(findings, model.ValidationFinding{Path: path + "/type", Code: model.ValidationRequired, Message: "content variable type might not be empty"})
+	} else if !isValidType(variable.Type) {
+		findings = append(findings, model.ValidationFinding{Path: path + "/type", Code: model.ValidationInvalidType, Message: fmt.Sprintf("unknown content variable type %q", variable.Type)})
+	} else if variable.Type != model.Structure && variable.Type != model.List && len(variable.SubContentVariables) > 0 {
+		findings = append(findings, model.ValidationFinding{Path: path + "/sub_content_variables", Code: model.ValidationNotAllowed, Message: "sub content variables are only allowed for structures and lists"})
 	}
 	if len(variable.CharacteristicId) > 0 {
-		characteristicIds = append(characteristicIds, variable.CharacteristicId)
+		*references = append(*references, contentVariableReference{kind: "characteristic", id: variable.CharacteristicId, path: path + "/characteristic_id"})
 	}
 	if len(variable.FunctionId) > 0 {
-		functionIds = append(functionIds, variable.FunctionId)
+		*references = append(*references, contentVariableReference{kind: "function", id: variable.FunctionId, path: path + "/function_id"})
 	}
 	if len(variable.AspectId) > 0 {
-		aspectIds = append(aspectIds, variable.AspectId)
+		*references = append(*references, contentVariableReference{kind: "aspect", id: variable.AspectId, path: path + "/aspect_id"})
 	}
-	for _, subVariable := range variable.SubContentVariables {
-		validInner, subCharacteristicIds, subFunctionIds, subAspectIds := this.validateContentVariableStep(token, subVariable)
-		if !validInner {
-			return validInner, characteristicIds, functionIds, aspectIds
+	for i, subVariable := range variable.SubContentVariables {
+		findings = append(findings, validateContentVariableStep(subVariable, path+"/sub_content_variables/"+strconv.Itoa(i), references)...)
+	}
+	return findings
+}
+
+// validateContentVariableReferences checks every referenced id once at the device-repository
+func (this *Controller) validateContentVariableReferences(references []contentVariableReference) (findings []model.ValidationFinding) {
+	checked := map[contentVariableReference]*model.ValidationFinding{}
+	for _, reference := range references {
+		key := contentVariableReference{kind: reference.kind, id: reference.id}
+		finding, ok := checked[key]
+		if !ok {
+			finding = this.checkContentVariableReference(reference.kind, reference.id)
+			checked[key] = finding
+		}
+		if finding != nil {
+			findings = append(findings, model.ValidationFinding{Path: reference.path, Code: finding.Code, Message: finding.Message})
 		}
-		characteristicIds = append(characteristicIds, subCharacteristicIds...)
-		functionIds = append(functionIds, subFunctionIds...)
-		aspectIds = append(aspectIds, subAspectIds...)
 	}
-	return true, characteristicIds, functionIds, aspectIds
+	return findings
+}
+
+func (this *Controller) checkContentVariableReference(kind string, id string) *model.ValidationFinding {
+	var err error
+	var code int
+	switch kind {
+	case "characteristic":
+		_, err, code = this.deviceRepoClient.GetCharacteristic(id)
+	case "function":
+		_, err, code = this.deviceRepoClient.GetFunction(id)
+	case "aspect":
+		_, err, code = this.deviceRepoClient.GetAspectNode(id)
+	}
+	if code == http.StatusNotFound {
+		return &model.ValidationFinding{Code: model.ValidationUnknownReference, Message: "unknown " + kind + " " + id}
+	}
+	if err != nil || code > 299 {
+		return &model.ValidationFinding{Code: model.ValidationReferenceCheckFailed, Message: fmt.Sprintf("unable to check %v %v: %v", kind, id, err)}
+	}
+	return nil
 }
 
 func contains(list []string, item string) bool {
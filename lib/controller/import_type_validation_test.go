@@ -0,0 +1,192 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	deviceRepo "github.com/SENERGY-Platform/device-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/models/go/models"
+	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
+)
+
+func TestValidateImportType(t *testing.T) {
+	deviceRepoClient, deviceRepoDb, err := deviceRepo.NewTestClient()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = deviceRepoDb.SetCharacteristic(context.Background(), models.Characteristic{Id: "c1", Name: "c1", Type: models.String})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	ctrl := &Controller{deviceRepoClient: deviceRepoClient}
+
+	t.Run("valid", func(t *testing.T) {
+		err, _ := ctrl.ValidateImportType(jwt.Token{}, model.ImportType{
+			Name:  "name",
+			Image: "image",
+			Configs: []model.ImportConfig{
+				{Name: "a", Type: model.Integer, DefaultValue: float64(1)},
+			},
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "value", Type: model.String, CharacteristicId: "c1"},
+				},
+			},
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err, code := ctrl.ValidateImportType(jwt.Token{}, model.ImportType{
+			Image: "image",
+			Configs: []model.ImportConfig{
+				{Name: "a", Type: model.Integer, DefaultValue: 1.5},
+				{Name: "a", Type: "foo"},
+			},
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "value", Type: model.String, CharacteristicId: "c1"},
+					{Name: "", Type: model.String, SubContentVariables: []model.ContentVariable{{Name: "sub", Type: model.String}}},
+					{Name: "unknown", Type: model.String, CharacteristicId: "unknown"},
+				},
+			},
+			Tags: []string{"weather", " ", "a,b", "weather"},
+		})
+		if code != http.StatusBadRequest {
+			t.Error(code)
+		}
+		var validationErr *model.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("%#v", err)
+			return
+		}
+		expected := []model.ValidationFinding{
+			{Path: "/name", Code: model.ValidationRequired},
+			{Path: "/configs/0/default_value", Code: model.ValidationInvalidDefaultValue},
+			{Path: "/configs/1/name", Code: model.ValidationDuplicate},
+			{Path: "/configs/1/type", Code: model.ValidationInvalidType},
+			{Path: "/output/sub_content_variables/1/name", Code: model.ValidationRequired},
+			{Path: "/output/sub_content_variables/1/sub_content_variables", Code: model.ValidationNotAllowed},
+			{Path: "/output/sub_content_variables/2/characteristic_id", Code: model.ValidationUnknownReference},
+			{Path: "/tags/1", Code: model.ValidationRequired},
+			{Path: "/tags/2", Code: model.ValidationNotAllowed},
+			{Path: "/tags/3", Code: model.ValidationDuplicate},
+		}
+		actual := []model.ValidationFinding{}
+		for _, finding := range validationErr.Findings {
+			if finding.Message == "" {
+				t.Errorf("missing message in %#v", finding)
+			}
+			actual = append(actual, model.ValidationFinding{Path: finding.Path, Code: finding.Code})
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("\n%#v\n%#v", actual, expected)
+		}
+	})
+
+	t.Run("config constraints", func(t *testing.T) {
+		minimum := float64(10)
+		maximum := float64(1)
+		findings := []model.ValidationFinding{}
+		for i, conf := range []model.ImportConfig{
+			{Name: "a", Type: model.String, Enum: []interface{}{"x", float64(1)}, DefaultValue: "y"},
+			{Name: "b", Type: model.Integer, Minimum: &minimum, Maximum: &maximum},
+			{Name: "c", Type: model.Boolean, Pattern: "x", Enum: []interface{}{true}, Minimum: &minimum},
+			{Name: "d", Type: model.String, Pattern: "("},
+			{Name: "e", Type: model.String, Pattern: "^[0-9]+$", DefaultValue: "abc"},
+			{Name: "f", Type: model.Float, Minimum: &maximum, DefaultValue: 0.5},
+		} {
+			findings = append(findings, validateConfig(conf, "/configs/"+strconv.Itoa(i))...)
+		}
+		expected := []model.ValidationFinding{
+			{Path: "/configs/0/enum/1", Code: model.ValidationInvalidValue},
+			{Path: "/configs/0/default_value", Code: model.ValidationInvalidDefaultValue},
+			{Path: "/configs/1/maximum", Code: model.ValidationInvalidRange},
+			{Path: "/configs/2/enum", Code: model.ValidationNotAllowed},
+			{Path: "/configs/2/minimum", Code: model.ValidationNotAllowed},
+			{Path: "/configs/2/pattern", Code: model.ValidationNotAllowed},
+			{Path: "/configs/3/pattern", Code: model.ValidationInvalidFormat},
+			{Path: "/configs/4/default_value", Code: model.ValidationInvalidDefaultValue},
+			{Path: "/configs/5/default_value", Code: model.ValidationInvalidDefaultValue},
+		}
+		actual := []model.ValidationFinding{}
+		for _, finding := range findings {
+			actual = append(actual, model.ValidationFinding{Path: finding.Path, Code: finding.Code})
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("\n%#v\n%#v", actual, expected)
+		}
+	})
+
+	t.Run("releases", func(t *testing.T) {
+		findings := validateReleases([]model.ImportTypeRelease{
+			{Version: "1.0.0", Image: "ghcr.io/senergy-platform/import:1.0.0", Channel: model.ReleaseChannelStable},
+			{Version: "1.1.0-beta.1", Image: "import@sha256:" + strings.Repeat("a", 64), Channel: model.ReleaseChannelBeta},
+			{Version: "1.1.0-beta.1", Image: "import:1.1.0", Channel: model.ReleaseChannelBeta},
+			{Version: "1.0.1", Image: "Invalid Image", Channel: "nightly"},
+			{Version: "v2", Channel: ""},
+		})
+		expected := []model.ValidationFinding{
+			{Path: "/releases/2/version", Code: model.ValidationDuplicate},
+			{Path: "/releases/3/version", Code: model.ValidationInvalidOrder},
+			{Path: "/releases/3/image", Code: model.ValidationInvalidFormat},
+			{Path: "/releases/3/channel", Code: model.ValidationInvalidFormat},
+			{Path: "/releases/4/version", Code: model.ValidationInvalidFormat},
+			{Path: "/releases/4/image", Code: model.ValidationRequired},
+			{Path: "/releases/4/channel", Code: model.ValidationRequired},
+		}
+		actual := []model.ValidationFinding{}
+		for _, finding := range findings {
+			actual = append(actual, model.ValidationFinding{Path: finding.Path, Code: finding.Code})
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("\n%#v\n%#v", actual, expected)
+		}
+	})
+
+	t.Run("device-repository unavailable", func(t *testing.T) {
+		ctrl := &Controller{deviceRepoClient: deviceRepo.NewClient("http://localhost:1", nil)}
+		err, code := ctrl.ValidateImportType(jwt.Token{}, model.ImportType{
+			Name:   "name",
+			Image:  "image",
+			Output: model.ContentVariable{Name: "output", Type: model.String, FunctionId: "f1"},
+		})
+		if code != http.StatusInternalServerError {
+			t.Error(code)
+		}
+		var validationErr *model.ValidationError
+		if !errors.As(err, &validationErr) || len(validationErr.Findings) != 1 || validationErr.Findings[0].Code != model.ValidationReferenceCheckFailed || validationErr.Findings[0].Path != "/output/function_id" {
+			t.Errorf("%#v", err)
+		}
+	})
+}
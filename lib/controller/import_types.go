@@ -18,9 +18,14 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"github.com/SENERGY-Platform/permissions-v2/pkg/client"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
 	"github.com/SENERGY-Platform/import-repository/lib/model"
 	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
 	"github.com/SENERGY-Platform/service-commons/pkg/jwt"
@@ -43,20 +48,29 @@ func (this *Controller) CreateImportType(importType model.ImportType, token jwt.
 		return result, errors.New("explicit setting of owner not allowed"), http.StatusBadRequest
 	}
 	importType.Owner = token.GetUserId()
-	if this.config.Validate {
-		err, code = this.ValidateImportType(token, importType)
-		if err != nil {
-			return result, err, code
-		}
+	importType = model.ResolveReleaseImage(importType)
+	err, code = this.checkImportType(token, importType)
+	if err != nil {
+		return result, err, code
 	}
-	ctx, _ := getTimeoutContext()
-	err = this.db.SetImportType(ctx, importType)
+	importType.Etag = ""
+	result, err = this.saveImportType(token, importType, "")
 	if err != nil {
 		return result, err, http.StatusInternalServerError
 	}
-	_, err, code = this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, importType.Id, client.ResourcePermissions{
+	_, err, code = this.permV2Client.SetPermission(client.InternalAdminToken, PermV2Topic, importType.Id, initialPermissions(importType.Owner))
+	if err != nil {
+		return result, err, code
+	}
+	this.publishImportType(result)
+	return result, nil, http.StatusCreated
+}
+
+// initialPermissions grants full access to the owner and the admin role
+func initialPermissions(owner string) client.ResourcePermissions {
+	return client.ResourcePermissions{
 		UserPermissions: map[string]permV2Model.PermissionsMap{
-			importType.Owner: {
+			owner: {
 				Read:         true,
 				Write:        true,
 				Execute:      true,
@@ -71,11 +85,7 @@ func (this *Controller) CreateImportType(importType model.ImportType, token jwt.
 				Administrate: true,
 			},
 		},
-	})
-	if err != nil {
-		return result, err, code
 	}
-	return importType, nil, http.StatusCreated
 }
 
 func (this *Controller) ReadImportType(id string, token jwt.Token) (result model.ImportType, err error, errCode int) {
@@ -92,70 +102,121 @@ func (this *Controller) ReadImportType(id string, token jwt.Token) (result model
 	if !exists {
 		return result, errors.New("not found"), http.StatusNotFound
 	}
-	return result, nil, http.StatusOK
+	return redactSecrets(result, token), nil, http.StatusOK
 }
 
 func (this *Controller) ListImportTypes(token jwt.Token, options model.ImportTypeListOptions) (result []model.ImportType, total int64, err error, errCode int) {
-	ids := []string{}
-	if options.Ids == nil {
-		if token.IsAdmin() {
-			ids = nil //no auth check for admins -> no id filter
-		} else {
-			ids, err, _ = this.permV2Client.ListAccessibleResourceIds(token.Token, PermV2Topic, permV2Model.ListOptions{}, permV2Model.Read)
-			if err != nil {
-				return result, total, err, http.StatusInternalServerError
-			}
+	if options.SortBy == "" {
+		options.SortBy = "name.asc"
+	}
+	if options.SearchMode != "" && options.SearchMode != model.SearchModeText && options.SearchMode != model.SearchModePrefix {
+		return result, total, errors.New("unknown search_mode " + string(options.SearchMode)), http.StatusBadRequest
+	}
+	if options.SortBy == model.SortByRelevance && (strings.TrimSpace(options.Search) == "" || options.SearchMode == model.SearchModePrefix) {
+		return result, total, errors.New("sort by relevance requires a text search"), http.StatusBadRequest
+	}
+	if _, ok := model.SortFields[model.SortField(options.SortBy)]; !ok && options.SortBy != model.SortByRelevance {
+		return result, total, errors.New("unknown sort field " + model.SortField(options.SortBy)), http.StatusBadRequest
+	}
+	if options.After != nil {
+		if !slices.Contains(model.CursorSortFields, model.SortField(options.SortBy)) {
+			return result, total, errors.New("cursor pagination is not supported for sort " + options.SortBy), http.StatusBadRequest
 		}
-	} else {
-		options.Limit = 0
-		options.Offset = 0
-		idMap, err, _ := this.permV2Client.CheckMultiplePermissions(token.Token, PermV2Topic, options.Ids, permV2Model.Read)
-		if err != nil {
-			return result, total, err, http.StatusInternalServerError
-		}
-		for id, ok := range idMap {
-			if ok {
-				ids = append(ids, id)
-			}
+		if options.After.SortBy != options.SortBy {
+			return result, total, errors.New("cursor does not match sort " + options.SortBy), http.StatusBadRequest
 		}
 	}
+	// the database applies sorting and pagination to the filtered ids, so that limit, offset, cursor and total stay correct.
+	options.Ids, err = this.readableImportTypeIds(token, options.Ids)
+	if err != nil {
+		return result, total, err, http.StatusInternalServerError
+	}
 	ctx, _ := getTimeoutContext()
 	result, total, err = this.db.ListImportTypes(ctx, options)
 	if err != nil {
 		return result, total, err, http.StatusInternalServerError
 	}
-	return result, total, nil, http.StatusOK
+	return redactSecretsInList(result, token), total, nil, http.StatusOK
 }
 
+// readableImportTypeIds restricts the ids filter of a list query to the import types the user may read.
+// admins may read every import type, so their ids are returned unchanged.
+func (this *Controller) readableImportTypeIds(token jwt.Token, ids []string) (result []string, err error) {
+	if token.IsAdmin() {
+		return ids, nil
+	}
+	result = []string{}
+	if ids == nil {
+		result, err, _ = this.permV2Client.ListAccessibleResourceIds(token.Token, PermV2Topic, permV2Model.ListOptions{}, permV2Model.Read)
+		if err != nil {
+			return result, err
+		}
+	} else if len(ids) > 0 {
+		idMap, err, _ := this.permV2Client.CheckMultiplePermissions(token.Token, PermV2Topic, ids, permV2Model.Read)
+		if err != nil {
+			return result, err
+		}
+		for _, id := range ids {
+			if idMap[id] {
+				result = append(result, id)
+			}
+		}
+	}
+	if result == nil {
+		result = []string{}
+	}
+	return result, nil
+}
+
+// SetImportType replaces the stored import type like SetImportTypeWithOptions without force
 func (this *Controller) SetImportType(importType model.ImportType, token jwt.Token) (err error, errCode int) {
+	_, err, errCode = this.SetImportTypeWithOptions(importType, false, token)
+	return err, errCode
+}
+
+// SetImportTypeWithOptions replaces the stored import type. updates with breaking changes (see diffImportTypes) are rejected with
+// a *model.BreakingChangesError and http.StatusConflict, unless force is set or the update bumps the release version.
+// the result is the persisted import type (secrets redacted for non owners) with the etag of the new version.
+func (this *Controller) SetImportTypeWithOptions(importType model.ImportType, force bool, token jwt.Token) (result model.ImportType, err error, errCode int) {
 	err, code := this.CheckAccessToImportType(token, importType.Id, permV2Model.Write)
 	if err != nil {
-		return err, code
+		return result, err, code
 	}
 	ctx, _ := getTimeoutContext()
 	existing, exists, err := this.db.GetImportType(ctx, importType.Id)
 	if err != nil {
-		return err, http.StatusInternalServerError
+		return result, err, http.StatusInternalServerError
 	}
 	if !exists {
-		return errors.New("not found"), http.StatusNotFound
+		return result, errors.New("not found"), http.StatusNotFound
 	}
 	if importType.Owner != existing.Owner {
-		return errors.New("transfer of ownership not possible!"), http.StatusBadRequest
+		return result, errors.New("transfer of ownership not possible!"), http.StatusBadRequest
 	}
-	if this.config.Validate {
-		err, code = this.ValidateImportType(token, importType)
-		if err != nil {
-			return err, code
-		}
+	importType = keepSecretDefaults(importType, existing, token)
+	etag := importType.Etag
+	importType.Etag = ""
+	if etag != "" && etag != existing.Etag {
+		return result, model.ErrPreconditionFailed, http.StatusPreconditionFailed
 	}
-	ctx, _ = getTimeoutContext()
-	err = this.db.SetImportType(ctx, importType)
+	importType = model.ResolveReleaseImage(importType)
+	err, code = this.checkImportType(token, importType)
 	if err != nil {
-		return err, http.StatusInternalServerError
+		return result, err, code
 	}
-
-	return nil, http.StatusOK
+	err, code = checkBreakingChanges(existing, importType, force)
+	if err != nil {
+		return result, err, code
+	}
+	result, err = this.saveImportType(token, importType, etag)
+	if errors.Is(err, model.ErrPreconditionFailed) {
+		return result, err, http.StatusPreconditionFailed
+	}
+	if err != nil {
+		return result, err, http.StatusInternalServerError
+	}
+	this.publishImportType(result)
+	return redactSecrets(result, token), nil, http.StatusOK
 }
 
 func (this *Controller) DeleteImportType(id string, token jwt.Token) (err error, errCode int) {
@@ -163,22 +224,110 @@ func (this *Controller) DeleteImportType(id string, token jwt.Token) (err error,
 	if err != nil {
 		return err, code
 	}
-	return this.deleteImportType(id)
+	return this.deleteImportType(id, token.GetUserId())
 }
 
-func (this *Controller) deleteImportType(id string) (err error, code int) {
-	err, code = this.permV2Client.RemoveResource(client.InternalAdminToken, PermV2Topic, id)
+// DeleteImportTypeIfMatch deletes the import type only if the stored version matches the etag
+func (this *Controller) DeleteImportTypeIfMatch(id string, etag string, token jwt.Token) (err error, errCode int) {
+	err, code := this.CheckAccessToImportType(token, id, permV2Model.Administrate)
 	if err != nil {
 		return err, code
 	}
+	if etag == "" {
+		return this.deleteImportType(id, token.GetUserId())
+	}
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if !exists {
+		return errors.New("not found"), http.StatusNotFound
+	}
+	if existing.Etag != etag {
+		return model.ErrPreconditionFailed, http.StatusPreconditionFailed
+	}
+	return this.removeImportType(existing, etag, token.GetUserId())
+}
+
+func (this *Controller) deleteImportType(id string, deletedBy string) (err error, code int) {
 	ctx, _ := getTimeoutContext()
-	err = this.db.RemoveImportType(ctx, id)
+	existing, exists, err := this.db.GetImportType(ctx, id)
 	if err != nil {
 		return err, http.StatusInternalServerError
 	}
+	if !exists {
+		// nothing to move to the trash; only the permissions are left to clean up
+		err, code = this.permV2Client.RemoveResource(client.InternalAdminToken, PermV2Topic, id)
+		if err != nil {
+			return err, code
+		}
+		return nil, http.StatusNoContent
+	}
+	return this.removeImportType(existing, "", deletedBy)
+}
+
+// removeImportType moves the import type with its current permissions to the trash and removes the permissions-v2 resource.
+// if etag is not empty, the import type is only removed if the stored version matches (the trash entry is reverted otherwise).
+// revisions are kept until the trash entry is purged.
+func (this *Controller) removeImportType(existing model.ImportType, etag string, deletedBy string) (err error, code int) {
+	trashed, err, code := this.newTrashEntry(existing, deletedBy)
+	if err != nil {
+		return err, code
+	}
+	return this.trashImportType(existing, trashed, etag)
+}
+
+// trashImportType stores the trash entry and removes the import type and its permissions-v2 resource, like removeImportType
+func (this *Controller) trashImportType(existing model.ImportType, trashed model.TrashedImportType, etag string) (err error, code int) {
+	ctx, _ := getTimeoutContext()
+	err = this.db.SetTrashedImportType(ctx, trashed)
+	if err != nil {
+		return err, http.StatusInternalServerError
+	}
+	if etag != "" {
+		err = this.db.RemoveImportTypeIfMatch(ctx, existing.Id, etag)
+	} else {
+		err = this.db.RemoveImportType(ctx, existing.Id)
+	}
+	if err != nil {
+		code = http.StatusInternalServerError
+		if errors.Is(err, model.ErrPreconditionFailed) {
+			code = http.StatusPreconditionFailed
+		}
+		rollbackErr := this.db.RemoveTrashedImportType(ctx, existing.Id)
+		if rollbackErr != nil {
+			err = errors.Join(err, fmt.Errorf("unable to remove trash entry: %w", rollbackErr))
+		}
+		return err, code
+	}
+	err, code = this.permV2Client.RemoveResource(client.InternalAdminToken, PermV2Topic, existing.Id)
+	if err != nil {
+		return err, code
+	}
+	this.publishImportTypeDelete(existing.Id, existing.Owner)
 	return nil, http.StatusNoContent
 }
 
+// publishImportType publishes a persisted change as last step of a request.
+// a failed publish does not fail the request; it is logged and downstream services are updated by the next change
+// or by republishing at startup (config.RepublishStartup).
+func (this *Controller) publishImportType(importType model.ImportType) {
+	importType.Etag = ""
+	err := this.producer.PublishImportType(importType)
+	if err != nil {
+		log.Logger.Error("unable to publish import type", "id", importType.Id, attributes.ErrorKey, err)
+	}
+}
+
+// publishImportTypeDelete publishes a persisted delete like publishImportType
+func (this *Controller) publishImportTypeDelete(id string, owner string) {
+	err := this.producer.PublishImportTypeDelete(id, owner)
+	if err != nil {
+		log.Logger.Error("unable to publish import type delete", "id", id, attributes.ErrorKey, err)
+	}
+}
+
 func (this *Controller) CheckAccessToImportType(token jwt.Token, id string, action permV2Model.Permission) (err error, errCode int) {
 	ok, err, errCode := this.permV2Client.CheckPermission(token.Token, PermV2Topic, id, action)
 	if err != nil {
@@ -18,6 +18,7 @@ package controller
 
 import (
 	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
 )
 
 func (this *Controller) DeleteUser(userId string) error {
@@ -43,22 +44,13 @@ func (this *Controller) DeleteUser(userId string) error {
 		delete(importType.UserPermissions, userId)
 
 		//other admin exists?
-		found := false
-		for _, perm := range importType.UserPermissions {
-			if perm.Administrate {
-				found = true
-				break
-			}
-		}
-
-		//no other admin user
-		if found {
+		if hasAdministratingUser(importType.ResourcePermissions) {
 			_, err, _ = this.permV2Client.SetPermission(permV2.InternalAdminToken, PermV2Topic, importType.Id, importType.ResourcePermissions)
 			if err != nil {
 				return err
 			}
 		} else {
-			err, _ = this.deleteImportType(importType.Id)
+			err = this.deleteImportTypeOfUser(importType.Id, userId, importType.ResourcePermissions)
 			if err != nil {
 				return err
 			}
@@ -66,3 +58,19 @@ func (this *Controller) DeleteUser(userId string) error {
 	}
 	return nil
 }
+
+// deleteImportTypeOfUser moves the import type of a deleted user to the trash.
+// the trash entry keeps the given permissions without the deleted user, so that a restore does not grant rights to a user who no longer exists.
+func (this *Controller) deleteImportTypeOfUser(id string, userId string, permissions permV2Model.ResourcePermissions) error {
+	ctx, _ := getTimeoutContext()
+	existing, exists, err := this.db.GetImportType(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		err, _ = this.permV2Client.RemoveResource(permV2.InternalAdminToken, PermV2Topic, id)
+		return err
+	}
+	err, _ = this.trashImportType(existing, newTrashEntryWithPermissions(existing, permissions, userId), "")
+	return err
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+)
+
+// Bolt implements database.Database with an embedded bbolt key/value store, persisted to config.BoltFile.
+// it is meant for deployments without mongodb; the file can only be opened by one process at a time.
+type Bolt struct {
+	db *bbolt.DB
+}
+
+var importTypeBucket = []byte("import_types")
+var importTypeNameIndexBucket = []byte("import_types_by_name")
+var importTypeFunctionIndexBucket = []byte("import_types_by_function")
+var importTypeAspectIndexBucket = []byte("import_types_by_aspect")
+var revisionBucket = []byte("import_type_revisions")
+var trashBucket = []byte("import_type_trash")
+var categoryBucket = []byte("import_type_categories")
+
+var buckets = [][]byte{
+	importTypeBucket,
+	importTypeNameIndexBucket,
+	importTypeFunctionIndexBucket,
+	importTypeAspectIndexBucket,
+	revisionBucket,
+	trashBucket,
+	categoryBucket,
+}
+
+func New(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (*Bolt, error) {
+	if conf.BoltFile == "" {
+		return nil, errors.New("missing bolt_file")
+	}
+	db, err := bbolt.Open(conf.BoltFile, 0600, &bbolt.Options{Timeout: 10 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, bucket := range buckets {
+			_, err := tx.CreateBucketIfNotExists(bucket)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	wg.Add(1)
+	go func() {
+		<-ctx.Done()
+		_ = db.Close()
+		wg.Done()
+	}()
+	return &Bolt{db: db}, nil
+}
+
+func (this *Bolt) CreateId() string {
+	return uuid.NewString()
+}
+
+func (this *Bolt) Disconnect() {
+	err := this.db.Close()
+	if err != nil {
+		log.Logger.Error("unable to close bolt file", attributes.ErrorKey, err)
+	}
+}
+
+type transactionKey struct{}
+
+type transaction struct {
+	db *Bolt
+	tx *bbolt.Tx
+}
+
+// Transaction executes f in a bbolt read-write transaction, which is rolled back if f returns an error.
+// nested transactions are part of the outer transaction; database calls of f must not be executed concurrently.
+func (this *Bolt) Transaction(ctx context.Context, f func(ctx context.Context) error) error {
+	if this.transaction(ctx) != nil {
+		return f(ctx)
+	}
+	return this.db.Update(func(tx *bbolt.Tx) error {
+		return f(context.WithValue(ctx, transactionKey{}, transaction{db: this, tx: tx}))
+	})
+}
+
+// transaction returns the read-write transaction of this database in ctx, or nil
+func (this *Bolt) transaction(ctx context.Context) *bbolt.Tx {
+	t, ok := ctx.Value(transactionKey{}).(transaction)
+	if !ok || t.db != this {
+		return nil
+	}
+	return t.tx
+}
+
+// view executes f in the transaction of ctx or in a new read-only transaction
+func (this *Bolt) view(ctx context.Context, f func(tx *bbolt.Tx) error) error {
+	if tx := this.transaction(ctx); tx != nil {
+		return f(tx)
+	}
+	return this.db.View(f)
+}
+
+// update executes f in the transaction of ctx or in a new read-write transaction
+func (this *Bolt) update(ctx context.Context, f func(tx *bbolt.Tx) error) error {
+	if tx := this.transaction(ctx); tx != nil {
+		return f(tx)
+	}
+	return this.db.Update(f)
+}
+
+func getValue[T any](bucket *bbolt.Bucket, key []byte) (result T, exists bool, err error) {
+	value := bucket.Get(key)
+	if value == nil {
+		return result, false, nil
+	}
+	err = json.Unmarshal(value, &result)
+	return result, true, err
+}
+
+func putValue(bucket *bbolt.Bucket, key []byte, value any) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, b)
+}
+
+// indexKey joins the parts with a zero byte, which sorts before all other characters;
+// the last part is the id of the indexed element
+func indexKey(parts ...string) []byte {
+	key := []byte{}
+	for i, part := range parts {
+		if i > 0 {
+			key = append(key, 0)
+		}
+		key = append(key, part...)
+	}
+	return key
+}
+
+// indexedId returns the last part of an index key
+func indexedId(key []byte) string {
+	for i := len(key) - 1; i >= 0; i-- {
+		if key[i] == 0 {
+			return string(key[i+1:])
+		}
+	}
+	return string(key)
+}
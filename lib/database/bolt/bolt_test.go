@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/database/bolt"
+	"github.com/SENERGY-Platform/import-repository/lib/database/databasetest"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestConformance(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	databasetest.Run(t, func(t *testing.T) database.Database {
+		db, err := bolt.New(config.Config{BoltFile: filepath.Join(t.TempDir(), "test.db")}, ctx, wg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(db.Disconnect)
+		return db
+	})
+}
+
+func TestPersistence(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	conf := config.Config{BoltFile: filepath.Join(t.TempDir(), "test.db")}
+
+	importType := model.ImportType{
+		Id:   "it",
+		Name: "persisted",
+		Output: model.ContentVariable{
+			Name:       "value",
+			Type:       model.Float,
+			FunctionId: "urn:infai:ses:measuring-function:getTemperature",
+		},
+	}
+	category := model.ImportTypeCategory{Id: "c", Name: "category"}
+
+	db, err := bolt.New(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = db.SetImportType(ctx, importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.AddImportTypeRevision(ctx, "user", importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = db.SetImportTypeCategory(ctx, category)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	db.Disconnect()
+
+	db, err = bolt.New(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer db.Disconnect()
+	list, _, err := db.ListImportTypes(ctx, model.ImportTypeListOptions{
+		Criteria: []model.ImportTypeFilterCriteria{{FunctionId: importType.Output.FunctionId}},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !reflect.DeepEqual(list, []model.ImportType{importType}) {
+		t.Errorf("\n%#v\n%#v", list, []model.ImportType{importType})
+	}
+	revision, exists, err := db.GetImportTypeRevision(ctx, importType.Id, 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !exists || !reflect.DeepEqual(revision.ImportType, importType) {
+		t.Errorf("%#v", revision)
+	}
+	actual, exists, err := db.GetImportTypeCategory(ctx, category.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !exists || actual != category {
+		t.Errorf("%#v", actual)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.etcd.io/bbolt"
+)
+
+// SetImportTypeCategory creates or replaces the category
+func (this *Bolt) SetImportTypeCategory(ctx context.Context, category model.ImportTypeCategory) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return putValue(tx.Bucket(categoryBucket), []byte(category.Id), category)
+	})
+}
+
+func (this *Bolt) GetImportTypeCategory(ctx context.Context, id string) (result model.ImportTypeCategory, exists bool, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		result, exists, err = getValue[model.ImportTypeCategory](tx.Bucket(categoryBucket), []byte(id))
+		return err
+	})
+	return result, exists, err
+}
+
+// ListImportTypeCategories returns all categories, ordered by name
+func (this *Bolt) ListImportTypeCategories(ctx context.Context) (result []model.ImportTypeCategory, err error) {
+	result = []model.ImportTypeCategory{}
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		return tx.Bucket(categoryBucket).ForEach(func(_, value []byte) error {
+			category := model.ImportTypeCategory{}
+			err := json.Unmarshal(value, &category)
+			result = append(result, category)
+			return err
+		})
+	})
+	query.SortCategories(result)
+	return result, err
+}
+
+func (this *Bolt) RemoveImportTypeCategory(ctx context.Context, id string) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return tx.Bucket(categoryBucket).Delete([]byte(id))
+	})
+}
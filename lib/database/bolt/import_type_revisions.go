@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.etcd.io/bbolt"
+)
+
+// revisionKey orders the revisions of an import type by number: the import type id, a zero byte and the big-endian revision number
+func revisionKey(importTypeId string, revision int64) []byte {
+	return binary.BigEndian.AppendUint64(revisionPrefix(importTypeId), uint64(revision))
+}
+
+func revisionPrefix(importTypeId string) []byte {
+	return indexKey(importTypeId, "")
+}
+
+// revisions returns the revisions of an import type in ascending order
+func revisions(tx *bbolt.Tx, importTypeId string) (result []model.ImportTypeRevision, err error) {
+	result = []model.ImportTypeRevision{}
+	prefix := revisionPrefix(importTypeId)
+	cursor := tx.Bucket(revisionBucket).Cursor()
+	for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+		if len(key) != len(prefix)+8 {
+			continue
+		}
+		revision, _, err := getValue[model.ImportTypeRevision](tx.Bucket(revisionBucket), key)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, revision)
+	}
+	return result, nil
+}
+
+func (this *Bolt) AddImportTypeRevision(ctx context.Context, author string, importType model.ImportType) (result model.ImportTypeRevision, err error) {
+	snapshot := query.NormalizeImportType(importType)
+	snapshot.Etag = ""
+	err = this.update(ctx, func(tx *bbolt.Tx) error {
+		existing, err := revisions(tx, importType.Id)
+		if err != nil {
+			return err
+		}
+		latest := int64(0)
+		if len(existing) > 0 {
+			latest = existing[len(existing)-1].Revision
+		}
+		result = model.ImportTypeRevision{
+			ImportTypeId: importType.Id,
+			Revision:     latest + 1,
+			Author:       author,
+			Date:         query.NormalizeTime(time.Now()),
+			ImportType:   snapshot,
+		}
+		return putValue(tx.Bucket(revisionBucket), revisionKey(importType.Id, result.Revision), result)
+	})
+	result.ImportType = importType
+	return result, err
+}
+
+func (this *Bolt) GetImportTypeRevision(ctx context.Context, importTypeId string, revision int64) (result model.ImportTypeRevision, exists bool, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		result, exists, err = getValue[model.ImportTypeRevision](tx.Bucket(revisionBucket), revisionKey(importTypeId, revision))
+		return err
+	})
+	return result, exists, err
+}
+
+func (this *Bolt) ListImportTypeRevisions(ctx context.Context, importTypeId string, listOptions model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		list, err := revisions(tx, importTypeId)
+		if err != nil {
+			return err
+		}
+		result, total = query.ListRevisions(list, listOptions)
+		return nil
+	})
+	return result, total, err
+}
+
+func (this *Bolt) RemoveImportTypeRevision(ctx context.Context, importTypeId string, revision int64) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return tx.Bucket(revisionBucket).Delete(revisionKey(importTypeId, revision))
+	})
+}
+
+func (this *Bolt) RemoveImportTypeRevisions(ctx context.Context, importTypeId string) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(revisionBucket)
+		prefix := revisionPrefix(importTypeId)
+		keys := [][]byte{}
+		cursor := bucket.Cursor()
+		for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+			if len(key) == len(prefix)+8 {
+				keys = append(keys, bytes.Clone(key))
+			}
+		}
+		for _, key := range keys {
+			err := bucket.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.etcd.io/bbolt"
+)
+
+// SetTrashedImportType stores the trash entry; an existing entry of the same import type is replaced
+func (this *Bolt) SetTrashedImportType(ctx context.Context, trashed model.TrashedImportType) error {
+	trashed.ImportType = query.NormalizeImportType(trashed.ImportType)
+	trashed.ImportType.Etag = ""
+	trashed.DeletedAt = query.NormalizeTime(trashed.DeletedAt)
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return putValue(tx.Bucket(trashBucket), []byte(trashed.ImportType.Id), trashed)
+	})
+}
+
+func (this *Bolt) GetTrashedImportType(ctx context.Context, id string) (result model.TrashedImportType, exists bool, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		result, exists, err = getValue[model.TrashedImportType](tx.Bucket(trashBucket), []byte(id))
+		return err
+	})
+	return result, exists, err
+}
+
+// ListTrashedImportTypes returns trash entries, newest deletion first
+func (this *Bolt) ListTrashedImportTypes(ctx context.Context, listOptions model.TrashListOptions) (result []model.TrashedImportType, total int64, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		entries := []model.TrashedImportType{}
+		err := tx.Bucket(trashBucket).ForEach(func(_, value []byte) error {
+			entry := model.TrashedImportType{}
+			err := json.Unmarshal(value, &entry)
+			entries = append(entries, entry)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result, total = query.ListTrash(entries, listOptions)
+		return nil
+	})
+	return result, total, err
+}
+
+func (this *Bolt) RemoveTrashedImportType(ctx context.Context, id string) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return tx.Bucket(trashBucket).Delete([]byte(id))
+	})
+}
@@ -0,0 +1,311 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.etcd.io/bbolt"
+)
+
+// importTypeDocument is the stored representation of an import type
+type importTypeDocument struct {
+	ImportType model.ImportType `json:"import_type"`
+	Etag       string           `json:"etag"`
+}
+
+func newImportTypeDocument(importType model.ImportType) (doc importTypeDocument, err error) {
+	doc.Etag, err = model.ImportTypeEtag(importType)
+	if err != nil {
+		return doc, err
+	}
+	doc.ImportType = query.NormalizeImportType(importType)
+	doc.ImportType.Etag = ""
+	return doc, nil
+}
+
+func (this importTypeDocument) importType() model.ImportType {
+	result := this.ImportType
+	result.Etag = this.Etag
+	return result
+}
+
+func (this *Bolt) GetImportType(ctx context.Context, id string) (importType model.ImportType, exists bool, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		var doc importTypeDocument
+		doc, exists, err = getValue[importTypeDocument](tx.Bucket(importTypeBucket), []byte(id))
+		importType = doc.importType()
+		return err
+	})
+	return importType, exists, err
+}
+
+func (this *Bolt) ListImportTypes(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportType, total int64, err error) {
+	filter := query.NewFilter(listOptions)
+	sort, err := query.ParseSort(listOptions.SortBy, filter.TextSearch() != nil)
+	if err != nil {
+		return result, total, err
+	}
+	if listOptions.Ids != nil && len(listOptions.Ids) == 0 {
+		return []model.ImportType{}, 0, nil
+	}
+	// without total, a list sorted by the name index is complete as soon as the requested page is found
+	stopAfter := 0
+	if listOptions.WithoutTotal && listOptions.After == nil && listOptions.Limit > 0 {
+		stopAfter = int(max(listOptions.Offset, 0) + listOptions.Limit)
+	}
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		var matches []model.ImportType
+		var sorted bool
+		matches, sorted, err = findImportTypes(tx, filter, listOptions, sort, stopAfter)
+		if err != nil {
+			return err
+		}
+		if !sorted {
+			query.SortImportTypes(matches, sort, filter.TextSearch())
+		}
+		result = query.Page(matches, sort, listOptions)
+		total = query.Total(len(matches), listOptions)
+		return nil
+	})
+	return result, total, err
+}
+
+func (this *Bolt) GetImportTypeFacets(ctx context.Context, listOptions model.ImportTypeListOptions) (result model.ImportTypeFacets, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		matches, _, err := findImportTypes(tx, query.NewFilter(listOptions), listOptions, query.Sort{}, 0)
+		result = query.Facets(matches, listOptions)
+		return err
+	})
+	return result, err
+}
+
+// ListImportTypeTags counts the import types matching the search, criteria, tags, categories and ids of the options per tag.
+// the result is sorted by count (descending) and tag.
+func (this *Bolt) ListImportTypeTags(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error) {
+	err = this.view(ctx, func(tx *bbolt.Tx) error {
+		matches, _, err := findImportTypes(tx, query.NewFilter(listOptions), listOptions, query.Sort{}, 0)
+		result = query.Tags(matches, listOptions)
+		return err
+	})
+	return result, err
+}
+
+// findImportTypes returns the import types matching the filter, with etag.
+// the criteria and ids of the list options select the candidates, if given; if sorted by name, the name index determines the order.
+// stopAfter limits the number of name sorted matches, if > 0.
+func findImportTypes(tx *bbolt.Tx, filter query.Filter, listOptions model.ImportTypeListOptions, sort query.Sort, stopAfter int) (result []model.ImportType, sorted bool, err error) {
+	candidates := criteriaCandidates(tx, listOptions.Criteria)
+	if listOptions.Ids != nil {
+		ids := map[string]bool{}
+		for _, id := range listOptions.Ids {
+			if candidates == nil || candidates[id] {
+				ids[id] = true
+			}
+		}
+		candidates = ids
+	}
+	importTypes := tx.Bucket(importTypeBucket)
+	result = []model.ImportType{}
+	add := func(id []byte) error {
+		doc, exists, err := getValue[importTypeDocument](importTypes, id)
+		if err != nil || !exists {
+			return err
+		}
+		if importType := doc.importType(); filter.Match(importType) {
+			result = append(result, importType)
+		}
+		return nil
+	}
+
+	if sort.Field == "name" {
+		cursor := tx.Bucket(importTypeNameIndexBucket).Cursor()
+		first, next := cursor.First, cursor.Next
+		if sort.Descending {
+			first, next = cursor.Last, cursor.Prev
+		}
+		for key, _ := first(); key != nil && (stopAfter <= 0 || len(result) < stopAfter); key, _ = next() {
+			id := indexedId(key)
+			if candidates != nil && !candidates[id] {
+				continue
+			}
+			err = add([]byte(id))
+			if err != nil {
+				return result, true, err
+			}
+		}
+		return result, true, nil
+	}
+
+	if candidates != nil {
+		for id := range candidates {
+			err = add([]byte(id))
+			if err != nil {
+				return result, false, err
+			}
+		}
+		return result, false, nil
+	}
+	err = importTypes.ForEach(func(id, _ []byte) error {
+		return add(id)
+	})
+	return result, false, err
+}
+
+// criteriaCandidates uses the function and aspect indexes to find the ids of import types matching all criteria; nil if no criteria restrict the result
+func criteriaCandidates(tx *bbolt.Tx, criteria []model.ImportTypeFilterCriteria) (result map[string]bool) {
+	functionIndex := tx.Bucket(importTypeFunctionIndexBucket)
+	aspectIndex := tx.Bucket(importTypeAspectIndexBucket)
+	for _, criterion := range criteria {
+		ids := map[string]bool{}
+		switch {
+		case criterion.FunctionId != "" && len(criterion.AspectIds) > 0:
+			for _, aspectId := range criterion.AspectIds {
+				scanIndex(functionIndex, indexKey(criterion.FunctionId, aspectId, ""), ids)
+			}
+		case criterion.FunctionId != "":
+			scanIndex(functionIndex, indexKey(criterion.FunctionId, ""), ids)
+		case len(criterion.AspectIds) > 0:
+			for _, aspectId := range criterion.AspectIds {
+				scanIndex(aspectIndex, indexKey(aspectId, ""), ids)
+			}
+		default:
+			// every import type has at least the criteria of its output root
+			continue
+		}
+		if result == nil {
+			result = ids
+			continue
+		}
+		for id := range result {
+			if !ids[id] {
+				delete(result, id)
+			}
+		}
+	}
+	return result
+}
+
+// scanIndex adds the ids of all index keys with the prefix to ids
+func scanIndex(index *bbolt.Bucket, prefix []byte, ids map[string]bool) {
+	cursor := index.Cursor()
+	for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+		ids[indexedId(key)] = true
+	}
+}
+
+type indexEntry struct {
+	bucket []byte
+	key    []byte
+}
+
+// importTypeIndexEntries returns the keys of the import type in the name, function and aspect indexes
+func importTypeIndexEntries(importType model.ImportType) (result []indexEntry) {
+	result = append(result, indexEntry{bucket: importTypeNameIndexBucket, key: indexKey(importType.Name, importType.Id)})
+	for _, criterion := range query.Criteria(importType.Output) {
+		result = append(result,
+			indexEntry{bucket: importTypeFunctionIndexBucket, key: indexKey(criterion.FunctionId, criterion.AspectId, importType.Id)},
+			indexEntry{bucket: importTypeAspectIndexBucket, key: indexKey(criterion.AspectId, criterion.FunctionId, importType.Id)},
+		)
+	}
+	return result
+}
+
+// putImportType stores the document and replaces the index entries of a previously stored version
+func putImportType(tx *bbolt.Tx, doc importTypeDocument) error {
+	err := deleteImportType(tx, doc.ImportType.Id)
+	if err != nil {
+		return err
+	}
+	err = putValue(tx.Bucket(importTypeBucket), []byte(doc.ImportType.Id), doc)
+	if err != nil {
+		return err
+	}
+	for _, entry := range importTypeIndexEntries(doc.ImportType) {
+		err = tx.Bucket(entry.bucket).Put(entry.key, []byte{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteImportType removes the document and its index entries, if it exists
+func deleteImportType(tx *bbolt.Tx, id string) error {
+	existing, exists, err := getValue[importTypeDocument](tx.Bucket(importTypeBucket), []byte(id))
+	if err != nil || !exists {
+		return err
+	}
+	for _, entry := range importTypeIndexEntries(existing.ImportType) {
+		err = tx.Bucket(entry.bucket).Delete(entry.key)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(importTypeBucket).Delete([]byte(id))
+}
+
+func (this *Bolt) SetImportType(ctx context.Context, importType model.ImportType) error {
+	doc, err := newImportTypeDocument(importType)
+	if err != nil {
+		return err
+	}
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return putImportType(tx, doc)
+	})
+}
+
+// SetImportTypeIfMatch replaces the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Bolt) SetImportTypeIfMatch(ctx context.Context, importType model.ImportType, etag string) error {
+	doc, err := newImportTypeDocument(importType)
+	if err != nil {
+		return err
+	}
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		existing, exists, err := getValue[importTypeDocument](tx.Bucket(importTypeBucket), []byte(importType.Id))
+		if err != nil {
+			return err
+		}
+		if !exists || existing.Etag != etag {
+			return model.ErrPreconditionFailed
+		}
+		return putImportType(tx, doc)
+	})
+}
+
+func (this *Bolt) RemoveImportType(ctx context.Context, id string) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		return deleteImportType(tx, id)
+	})
+}
+
+// RemoveImportTypeIfMatch removes the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Bolt) RemoveImportTypeIfMatch(ctx context.Context, id string, etag string) error {
+	return this.update(ctx, func(tx *bbolt.Tx) error {
+		existing, exists, err := getValue[importTypeDocument](tx.Bucket(importTypeBucket), []byte(id))
+		if err != nil {
+			return err
+		}
+		if !exists || existing.Etag != etag {
+			return model.ErrPreconditionFailed
+		}
+		return deleteImportType(tx, id)
+	})
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bolt
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.etcd.io/bbolt"
+)
+
+func TestIndexes(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db, err := New(config.Config{BoltFile: filepath.Join(t.TempDir(), "test.db")}, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	indexKeys := func(bucket []byte) (result []string) {
+		result = []string{}
+		err := db.db.View(func(tx *bbolt.Tx) error {
+			return tx.Bucket(bucket).ForEach(func(key, _ []byte) error {
+				result = append(result, string(key))
+				return nil
+			})
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		return result
+	}
+	check := func(name []string, function []string, aspect []string) {
+		t.Helper()
+		if actual := indexKeys(importTypeNameIndexBucket); !reflect.DeepEqual(actual, name) {
+			t.Errorf("name index: %q", actual)
+		}
+		if actual := indexKeys(importTypeFunctionIndexBucket); !reflect.DeepEqual(actual, function) {
+			t.Errorf("function index: %q", actual)
+		}
+		if actual := indexKeys(importTypeAspectIndexBucket); !reflect.DeepEqual(actual, aspect) {
+			t.Errorf("aspect index: %q", actual)
+		}
+	}
+
+	importType := model.ImportType{
+		Id:   "it",
+		Name: "first",
+		Output: model.ContentVariable{
+			Name: "output",
+			SubContentVariables: []model.ContentVariable{
+				{Name: "value", FunctionId: "f1", AspectId: "a1"},
+			},
+		},
+	}
+	err = db.SetImportType(ctx, importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	check([]string{"first\x00it"}, []string{"\x00\x00it", "f1\x00a1\x00it"}, []string{"\x00\x00it", "a1\x00f1\x00it"})
+
+	// the entries of the replaced version are removed
+	importType.Name = "second"
+	importType.Output.SubContentVariables[0].FunctionId = "f2"
+	err = db.SetImportType(ctx, importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	check([]string{"second\x00it"}, []string{"\x00\x00it", "f2\x00a1\x00it"}, []string{"\x00\x00it", "a1\x00f2\x00it"})
+
+	err = db.RemoveImportType(ctx, importType.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	check([]string{}, []string{}, []string{})
+}
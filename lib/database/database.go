@@ -18,11 +18,31 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"sync"
+
 	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/database/bolt"
+	"github.com/SENERGY-Platform/import-repository/lib/database/memory"
 	"github.com/SENERGY-Platform/import-repository/lib/database/mongo"
-	"sync"
 )
 
+const (
+	BackendMongo  = "mongo"
+	BackendMemory = "memory"
+	BackendBolt   = "bolt"
+)
+
+// New creates the database selected by config.DatabaseBackend; mongodb is used if no backend is configured
 func New(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (db Database, err error) {
-	return mongo.New(conf, ctx, wg)
+	switch conf.DatabaseBackend {
+	case "", BackendMongo:
+		return mongo.New(conf, ctx, wg)
+	case BackendMemory:
+		return memory.New(), nil
+	case BackendBolt:
+		return bolt.New(conf, ctx, wg)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", conf.DatabaseBackend)
+	}
 }
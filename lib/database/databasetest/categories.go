@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func testCategories(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	list, err := db.ListImportTypeCategories(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !reflect.DeepEqual(list, []model.ImportTypeCategory{}) {
+		t.Errorf("%#v", list)
+		return
+	}
+
+	beta := model.ImportTypeCategory{Id: "c2", Name: "Beta", Description: "second"}
+	alpha := model.ImportTypeCategory{Id: "c3", Name: "Alpha"}
+	otherAlpha := model.ImportTypeCategory{Id: "c1", Name: "Alpha"}
+	for _, category := range []model.ImportTypeCategory{beta, alpha, otherAlpha} {
+		err = db.SetImportTypeCategory(ctx, category)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	list, err = db.ListImportTypeCategories(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !reflect.DeepEqual(list, []model.ImportTypeCategory{otherAlpha, alpha, beta}) {
+		t.Errorf("%#v", list)
+	}
+
+	beta.Name = "Aardvark"
+	err = db.SetImportTypeCategory(ctx, beta)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	actual, exists, err := db.GetImportTypeCategory(ctx, beta.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !exists || !reflect.DeepEqual(actual, beta) {
+		t.Errorf("%#v", actual)
+	}
+
+	err = db.RemoveImportTypeCategory(ctx, alpha.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, exists, err = db.GetImportTypeCategory(ctx, alpha.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if exists {
+		t.Error("category not removed")
+	}
+	list, err = db.ListImportTypeCategories(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !reflect.DeepEqual(list, []model.ImportTypeCategory{beta, otherAlpha}) {
+		t.Errorf("%#v", list)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package databasetest contains behaviour tests, which every implementation of database.Database has to pass.
+// the tests describe the semantics of the mongodb implementation.
+package databasetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// Run executes all behaviour tests; newDatabase has to return a new, empty database for every call
+func Run(t *testing.T, newDatabase func(t *testing.T) database.Database) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, db database.Database)
+	}{
+		{"import types", testImportTypes},
+		{"preconditions", testPreconditions},
+		{"list filter", testListFilter},
+		{"list search", testListSearch},
+		{"list sort", testListSort},
+		{"list pagination", testListPagination},
+		{"facets and tags", testFacetsAndTags},
+		{"revisions", testRevisions},
+		{"trash", testTrash},
+		{"categories", testCategories},
+		{"transaction", testTransaction},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.test(t, newDatabase(t))
+		})
+	}
+}
+
+const (
+	getTemperatureFunction = "urn:infai:ses:measuring-function:getTemperature"
+	getHumidityFunction    = "urn:infai:ses:measuring-function:getHumidity"
+	getColorFunction       = "urn:infai:ses:measuring-function:getColor"
+	airAspect              = "urn:infai:ses:aspect:air"
+	deviceAspect           = "urn:infai:ses:aspect:device"
+	celsiusCharacteristic  = "urn:infai:ses:characteristic:celsius"
+	rgbCharacteristic      = "urn:infai:ses:characteristic:rgb"
+)
+
+// fixtures returns import types sorted by id; ordered by name they are: c, a, d, e, b
+func fixtures() []model.ImportType {
+	return []model.ImportType{
+		{
+			Id:             "a",
+			Name:           "Weather Station",
+			Description:    "measures temperature",
+			DefaultRestart: true,
+			Owner:          "owner1",
+			Cost:           0,
+			Tags:           []string{"weather", "outdoor"},
+			Category:       "sensors",
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "temperature", Type: model.Float, FunctionId: getTemperatureFunction, AspectId: airAspect, CharacteristicId: celsiusCharacteristic},
+					{Name: "humidity", Type: model.Float, FunctionId: getHumidityFunction, AspectId: airAspect},
+				},
+			},
+		},
+		{
+			Id:          "b",
+			Name:        "weather data archive",
+			Description: "historical weather",
+			Owner:       "owner2",
+			Cost:        5,
+			Tags:        []string{"weather"},
+			Category:    "archives",
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "value", Type: model.String, FunctionId: getColorFunction, AspectId: deviceAspect},
+				},
+			},
+		},
+		{
+			Id:       "c",
+			Name:     "Color Sensor",
+			Owner:    "owner1",
+			Cost:     150,
+			Category: "sensors",
+			Configs: []model.ImportConfig{
+				{Name: "brightness", Description: "weather dependent", Type: model.Integer, DefaultValue: float64(3)},
+				{Name: "mapping", Type: model.Structure, DefaultValue: map[string]interface{}{"red": "r", "levels": []interface{}{float64(1), float64(2)}}},
+			},
+			Output: model.ContentVariable{
+				Name: "output",
+				Type: model.Structure,
+				SubContentVariables: []model.ContentVariable{
+					{Name: "color", Type: model.String, FunctionId: getColorFunction, AspectId: deviceAspect, CharacteristicId: rgbCharacteristic},
+					{Name: "temp", Type: model.Float, FunctionId: getTemperatureFunction, AspectId: deviceAspect},
+				},
+			},
+		},
+		{
+			Id:             "d",
+			Name:           "a.b name",
+			DefaultRestart: true,
+			Cost:           2000,
+			Output:         model.ContentVariable{Name: "output", Type: model.String},
+		},
+		{
+			Id:     "e",
+			Name:   "axb name",
+			Owner:  "owner2",
+			Cost:   10,
+			Tags:   []string{"outdoor"},
+			Output: model.ContentVariable{Name: "output", Type: model.String},
+		},
+	}
+}
+
+func setImportTypes(ctx context.Context, db database.Database, importTypes ...model.ImportType) error {
+	for _, importType := range importTypes {
+		err := db.SetImportType(ctx, importType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listIds(ctx context.Context, db database.Database, listOptions model.ImportTypeListOptions) (ids []string, total int64, err error) {
+	list, total, err := db.ListImportTypes(ctx, listOptions)
+	if err != nil {
+		return ids, total, err
+	}
+	ids = []string{}
+	for _, element := range list {
+		ids = append(ids, element.Id)
+	}
+	return ids, total, nil
+}
+
+func withEtag(importType model.ImportType) model.ImportType {
+	importType.Etag, _ = model.ImportTypeEtag(importType)
+	return importType
+}
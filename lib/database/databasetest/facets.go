@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func costBuckets(counts ...int64) []model.CostBucketCount {
+	result := []model.CostBucketCount{}
+	for i, lower := range model.CostBuckets {
+		bucket := model.CostBucketCount{Min: lower, Count: counts[i]}
+		if i+1 < len(model.CostBuckets) {
+			upper := model.CostBuckets[i+1]
+			bucket.Max = &upper
+		}
+		result = append(result, bucket)
+	}
+	return result
+}
+
+func testFacetsAndTags(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	err := setImportTypes(ctx, db, fixtures()...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("facets", func(t *testing.T) {
+		facets, err := db.GetImportTypeFacets(ctx, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := model.ImportTypeFacets{
+			Functions: []model.FacetCount{
+				{Value: getColorFunction, Count: 2},
+				{Value: getTemperatureFunction, Count: 2},
+				{Value: getHumidityFunction, Count: 1},
+			},
+			Aspects: []model.FacetCount{
+				{Value: deviceAspect, Count: 2},
+				{Value: airAspect, Count: 1},
+			},
+			Characteristics: []model.FacetCount{
+				{Value: celsiusCharacteristic, Count: 1},
+				{Value: rgbCharacteristic, Count: 1},
+			},
+			Owners: []model.FacetCount{
+				{Value: "owner1", Count: 2},
+				{Value: "owner2", Count: 2},
+			},
+			Costs: costBuckets(1, 1, 1, 1, 1),
+		}
+		if !reflect.DeepEqual(facets, expected) {
+			t.Errorf("\n%#v\n%#v", facets, expected)
+		}
+	})
+
+	t.Run("filtered facets", func(t *testing.T) {
+		facets, err := db.GetImportTypeFacets(ctx, model.ImportTypeListOptions{Search: "weather", Ids: []string{"a", "b", "d"}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := model.ImportTypeFacets{
+			Functions: []model.FacetCount{
+				{Value: getColorFunction, Count: 1},
+				{Value: getHumidityFunction, Count: 1},
+				{Value: getTemperatureFunction, Count: 1},
+			},
+			Aspects: []model.FacetCount{
+				{Value: airAspect, Count: 1},
+				{Value: deviceAspect, Count: 1},
+			},
+			Characteristics: []model.FacetCount{
+				{Value: celsiusCharacteristic, Count: 1},
+			},
+			Owners: []model.FacetCount{
+				{Value: "owner1", Count: 1},
+				{Value: "owner2", Count: 1},
+			},
+			Costs: costBuckets(1, 1, 0, 0, 0),
+		}
+		if !reflect.DeepEqual(facets, expected) {
+			t.Errorf("\n%#v\n%#v", facets, expected)
+		}
+	})
+
+	t.Run("empty facets", func(t *testing.T) {
+		facets, err := db.GetImportTypeFacets(ctx, model.ImportTypeListOptions{Ids: []string{}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := model.ImportTypeFacets{
+			Functions:       []model.FacetCount{},
+			Aspects:         []model.FacetCount{},
+			Characteristics: []model.FacetCount{},
+			Owners:          []model.FacetCount{},
+			Costs:           costBuckets(0, 0, 0, 0, 0),
+		}
+		if !reflect.DeepEqual(facets, expected) {
+			t.Errorf("\n%#v\n%#v", facets, expected)
+		}
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		tags, err := db.ListImportTypeTags(ctx, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := []model.ImportTypeTagCount{{Tag: "outdoor", Count: 2}, {Tag: "weather", Count: 2}}
+		if !reflect.DeepEqual(tags, expected) {
+			t.Errorf("\n%#v\n%#v", tags, expected)
+		}
+		tags, err = db.ListImportTypeTags(ctx, model.ImportTypeListOptions{Categories: []string{"archives"}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected = []model.ImportTypeTagCount{{Tag: "weather", Count: 1}}
+		if !reflect.DeepEqual(tags, expected) {
+			t.Errorf("\n%#v\n%#v", tags, expected)
+		}
+		tags, err = db.ListImportTypeTags(ctx, model.ImportTypeListOptions{Ids: []string{"c", "d"}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(tags, []model.ImportTypeTagCount{}) {
+			t.Errorf("%#v", tags)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func testImportTypes(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	importTypes := fixtures()
+
+	_, exists, err := db.GetImportType(ctx, "a")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if exists {
+		t.Error("unexpected import type in empty database")
+		return
+	}
+
+	err = setImportTypes(ctx, db, importTypes...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, expected := range importTypes {
+		actual, exists, err := db.GetImportType(ctx, expected.Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !exists {
+			t.Error("missing import type", expected.Id)
+			continue
+		}
+		if !reflect.DeepEqual(actual, withEtag(expected)) {
+			t.Errorf("\n%#v\n%#v", actual, withEtag(expected))
+		}
+	}
+
+	t.Run("etag is ignored on write", func(t *testing.T) {
+		importType := importTypes[0]
+		importType.Etag = "foo"
+		err = db.SetImportType(ctx, importType)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		actual, _, err := db.GetImportType(ctx, importType.Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if actual.Etag != withEtag(importTypes[0]).Etag {
+			t.Error(actual.Etag)
+		}
+	})
+
+	t.Run("list etags", func(t *testing.T) {
+		list, _, err := db.ListImportTypes(ctx, model.ImportTypeListOptions{SortBy: "id.asc"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		for _, element := range list {
+			if element.Etag != "" {
+				t.Error("unexpected etag", element.Id)
+			}
+		}
+		list, _, err = db.ListImportTypes(ctx, model.ImportTypeListOptions{SortBy: "id.asc", WithEtag: true})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := []model.ImportType{}
+		for _, importType := range importTypes {
+			expected = append(expected, withEtag(importType))
+		}
+		if !reflect.DeepEqual(list, expected) {
+			t.Errorf("\n%#v\n%#v", list, expected)
+		}
+	})
+
+	t.Run("stored values are copies", func(t *testing.T) {
+		importType := fixtures()[0]
+		err = db.SetImportType(ctx, importType)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		importType.Tags[0] = "changed"
+		actual, _, err := db.GetImportType(ctx, importType.Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		actual.Output.SubContentVariables[0].Name = "changed"
+		actual, _, err = db.GetImportType(ctx, importType.Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(actual, withEtag(fixtures()[0])) {
+			t.Errorf("\n%#v\n%#v", actual, withEtag(fixtures()[0]))
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err = db.RemoveImportType(ctx, "a")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		err = db.RemoveImportType(ctx, "unknown")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, exists, err := db.GetImportType(ctx, "a")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("import type not removed")
+		}
+		ids, total, err := listIds(ctx, db, model.ImportTypeListOptions{SortBy: "id.asc"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(ids, []string{"b", "c", "d", "e"}) || total != 4 {
+			t.Error(ids, total)
+		}
+	})
+}
+
+func testPreconditions(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	importType := fixtures()[0]
+
+	err := db.SetImportTypeIfMatch(ctx, importType, "")
+	if !errors.Is(err, model.ErrPreconditionFailed) {
+		t.Error("expected precondition error for unknown import type", err)
+		return
+	}
+	err = db.RemoveImportTypeIfMatch(ctx, importType.Id, "")
+	if !errors.Is(err, model.ErrPreconditionFailed) {
+		t.Error("expected precondition error for unknown import type", err)
+		return
+	}
+
+	err = db.SetImportType(ctx, importType)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	etag := withEtag(importType).Etag
+
+	changed := importType
+	changed.Name = "changed"
+	err = db.SetImportTypeIfMatch(ctx, changed, "wrong")
+	if !errors.Is(err, model.ErrPreconditionFailed) {
+		t.Error("expected precondition error for wrong etag", err)
+		return
+	}
+	err = db.SetImportTypeIfMatch(ctx, changed, etag)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	actual, _, err := db.GetImportType(ctx, importType.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !reflect.DeepEqual(actual, withEtag(changed)) || actual.Etag == etag {
+		t.Errorf("\n%#v\n%#v", actual, withEtag(changed))
+		return
+	}
+
+	// the etag of the replaced version is outdated
+	err = db.SetImportTypeIfMatch(ctx, importType, etag)
+	if !errors.Is(err, model.ErrPreconditionFailed) {
+		t.Error("expected precondition error for outdated etag", err)
+		return
+	}
+	err = db.RemoveImportTypeIfMatch(ctx, importType.Id, etag)
+	if !errors.Is(err, model.ErrPreconditionFailed) {
+		t.Error("expected precondition error for outdated etag", err)
+		return
+	}
+	err = db.RemoveImportTypeIfMatch(ctx, importType.Id, actual.Etag)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, exists, err := db.GetImportType(ctx, importType.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if exists {
+		t.Error("import type not removed")
+	}
+}
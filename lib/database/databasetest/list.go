@@ -0,0 +1,207 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+type listCase struct {
+	name     string
+	options  model.ImportTypeListOptions
+	expected []string
+	total    int64
+}
+
+func runListCases(t *testing.T, db database.Database, cases []listCase) {
+	ctx := context.Background()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids, total, err := listIds(ctx, db, c.options)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !reflect.DeepEqual(ids, c.expected) || total != c.total {
+				t.Errorf("\n%v %v\n%v %v", ids, total, c.expected, c.total)
+			}
+		})
+	}
+}
+
+func testListFilter(t *testing.T, db database.Database) {
+	err := setImportTypes(context.Background(), db, fixtures()...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	runListCases(t, db, []listCase{
+		{name: "all", options: model.ImportTypeListOptions{}, expected: []string{"c", "a", "d", "e", "b"}, total: 5},
+		{name: "empty ids", options: model.ImportTypeListOptions{Ids: []string{}}, expected: []string{}, total: 0},
+		{name: "ids", options: model.ImportTypeListOptions{Ids: []string{"b", "a", "b", "unknown"}}, expected: []string{"a", "b"}, total: 2},
+		{name: "function", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{
+			{FunctionId: getTemperatureFunction},
+		}}, expected: []string{"c", "a"}, total: 2},
+		{name: "aspects", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{
+			{AspectIds: []string{deviceAspect, "unknown"}},
+		}}, expected: []string{"c", "b"}, total: 2},
+		{name: "function and aspect", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{
+			{FunctionId: getTemperatureFunction, AspectIds: []string{airAspect}},
+		}}, expected: []string{"a"}, total: 1},
+		{name: "function and aspect of different variables", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{
+			{FunctionId: getColorFunction, AspectIds: []string{airAspect}},
+		}}, expected: []string{}, total: 0},
+		{name: "multiple criteria", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{
+			{FunctionId: getTemperatureFunction},
+			{FunctionId: getColorFunction},
+		}}, expected: []string{"c"}, total: 1},
+		{name: "empty criteria", options: model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{{}}}, expected: []string{"c", "a", "d", "e", "b"}, total: 5},
+		{name: "tags", options: model.ImportTypeListOptions{Tags: []string{"weather"}}, expected: []string{"a", "b"}, total: 2},
+		{name: "all tags", options: model.ImportTypeListOptions{Tags: []string{"outdoor", "weather"}}, expected: []string{"a"}, total: 1},
+		{name: "categories", options: model.ImportTypeListOptions{Categories: []string{"sensors", "archives"}}, expected: []string{"c", "a", "b"}, total: 3},
+		{name: "combined", options: model.ImportTypeListOptions{
+			Ids:        []string{"a", "b", "c"},
+			Categories: []string{"sensors"},
+			Criteria:   []model.ImportTypeFilterCriteria{{AspectIds: []string{airAspect}}},
+		}, expected: []string{"a"}, total: 1},
+	})
+}
+
+func testListSearch(t *testing.T, db database.Database) {
+	err := setImportTypes(context.Background(), db, fixtures()...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	runListCases(t, db, []listCase{
+		{name: "prefix mode is case insensitive", options: model.ImportTypeListOptions{Search: "NAME", SearchMode: model.SearchModePrefix}, expected: []string{"d", "e"}, total: 2},
+		{name: "prefix mode escapes regex characters", options: model.ImportTypeListOptions{Search: "a.b", SearchMode: model.SearchModePrefix}, expected: []string{"d"}, total: 1},
+		{name: "text", options: model.ImportTypeListOptions{Search: "weather"}, expected: []string{"c", "a", "b"}, total: 3},
+		{name: "text by relevance", options: model.ImportTypeListOptions{Search: "weather", SortBy: model.SortByRelevance}, expected: []string{"b", "a", "c"}, total: 3},
+		{name: "text is case insensitive", options: model.ImportTypeListOptions{Search: "SENSOR"}, expected: []string{"c"}, total: 1},
+		{name: "text matches output names", options: model.ImportTypeListOptions{Search: "humidity"}, expected: []string{"a"}, total: 1},
+		{name: "text matches config names", options: model.ImportTypeListOptions{Search: "brightness"}, expected: []string{"c"}, total: 1},
+		{name: "text without stemming", options: model.ImportTypeListOptions{Search: "temp"}, expected: []string{"c"}, total: 1},
+		{name: "text with any term", options: model.ImportTypeListOptions{Search: "humidity color"}, expected: []string{"c", "a"}, total: 2},
+		{name: "text with negation", options: model.ImportTypeListOptions{Search: "weather -archive"}, expected: []string{"c", "a"}, total: 2},
+		{name: "text with phrase", options: model.ImportTypeListOptions{Search: "\"weather station\""}, expected: []string{"a"}, total: 1},
+		{name: "whitespace is no search", options: model.ImportTypeListOptions{Search: "  "}, expected: []string{"c", "a", "d", "e", "b"}, total: 5},
+	})
+
+	_, _, err = db.ListImportTypes(context.Background(), model.ImportTypeListOptions{SortBy: model.SortByRelevance})
+	if err == nil {
+		t.Error("expected error for relevance sort without text search")
+	}
+	_, _, err = db.ListImportTypes(context.Background(), model.ImportTypeListOptions{Search: "weather", SearchMode: model.SearchModePrefix, SortBy: model.SortByRelevance})
+	if err == nil {
+		t.Error("expected error for relevance sort without text search")
+	}
+}
+
+func testListSort(t *testing.T, db database.Database) {
+	err := setImportTypes(context.Background(), db, fixtures()...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	runListCases(t, db, []listCase{
+		{name: "name.asc", options: model.ImportTypeListOptions{SortBy: "name.asc"}, expected: []string{"c", "a", "d", "e", "b"}, total: 5},
+		{name: "name.desc", options: model.ImportTypeListOptions{SortBy: "name.desc"}, expected: []string{"b", "e", "d", "a", "c"}, total: 5},
+		{name: "name", options: model.ImportTypeListOptions{SortBy: "name"}, expected: []string{"c", "a", "d", "e", "b"}, total: 5},
+		{name: "id.desc", options: model.ImportTypeListOptions{SortBy: "id.desc"}, expected: []string{"e", "d", "c", "b", "a"}, total: 5},
+		{name: "cost.asc", options: model.ImportTypeListOptions{SortBy: "cost.asc"}, expected: []string{"a", "b", "e", "c", "d"}, total: 5},
+		{name: "cost.desc", options: model.ImportTypeListOptions{SortBy: "cost.desc"}, expected: []string{"d", "c", "e", "b", "a"}, total: 5},
+		{name: "ties ordered by id", options: model.ImportTypeListOptions{SortBy: "owner.asc"}, expected: []string{"d", "a", "c", "b", "e"}, total: 5},
+		{name: "ties ordered by id in sort direction", options: model.ImportTypeListOptions{SortBy: "owner.desc"}, expected: []string{"e", "b", "c", "a", "d"}, total: 5},
+		{name: "multi-word field", options: model.ImportTypeListOptions{SortBy: "default_restart.asc"}, expected: []string{"b", "c", "e", "a", "d"}, total: 5},
+		{name: "multi-word field desc", options: model.ImportTypeListOptions{SortBy: "default_restart.desc"}, expected: []string{"d", "a", "e", "c", "b"}, total: 5},
+		{name: "nested field", options: model.ImportTypeListOptions{SortBy: "output.type.desc"}, expected: []string{"e", "d", "c", "b", "a"}, total: 5},
+	})
+	_, _, err = db.ListImportTypes(context.Background(), model.ImportTypeListOptions{SortBy: "unknown.asc"})
+	if err == nil {
+		t.Error("expected error for unknown sort field")
+	}
+}
+
+func testListPagination(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	err := setImportTypes(ctx, db, fixtures()...)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	runListCases(t, db, []listCase{
+		{name: "limit", options: model.ImportTypeListOptions{Limit: 2}, expected: []string{"c", "a"}, total: 5},
+		{name: "limit and offset", options: model.ImportTypeListOptions{Limit: 2, Offset: 1}, expected: []string{"a", "d"}, total: 5},
+		{name: "offset", options: model.ImportTypeListOptions{Offset: 3}, expected: []string{"e", "b"}, total: 5},
+		{name: "offset behind end", options: model.ImportTypeListOptions{Limit: 2, Offset: 10}, expected: []string{}, total: 5},
+		{name: "filtered total", options: model.ImportTypeListOptions{Limit: 1, Tags: []string{"weather"}}, expected: []string{"a"}, total: 2},
+		{name: "without total", options: model.ImportTypeListOptions{Limit: 2, WithoutTotal: true}, expected: []string{"c", "a"}, total: -1},
+		{name: "after ignores offset", options: model.ImportTypeListOptions{Limit: 2, Offset: 3, SortBy: "name.asc",
+			After: &model.ImportTypeCursor{SortBy: "name.asc", Value: "Weather Station", Id: "a"}}, expected: []string{"d", "e"}, total: 5},
+		{name: "after with integer value", options: model.ImportTypeListOptions{SortBy: "cost.desc",
+			After: &model.ImportTypeCursor{SortBy: "cost.desc", Value: 10, Id: "e"}}, expected: []string{"b", "a"}, total: 5},
+		{name: "after value and id", options: model.ImportTypeListOptions{SortBy: "owner.asc",
+			After: &model.ImportTypeCursor{SortBy: "owner.asc", Value: "owner1", Id: "a"}}, expected: []string{"c", "b", "e"}, total: 5},
+		{name: "after id", options: model.ImportTypeListOptions{SortBy: "id.desc",
+			After: &model.ImportTypeCursor{SortBy: "id.desc", Value: "c", Id: "c"}}, expected: []string{"b", "a"}, total: 5},
+	})
+
+	for _, sortBy := range []string{"name.asc", "name.desc", "cost.asc", "owner.desc", "id.asc"} {
+		t.Run("cursor pages "+sortBy, func(t *testing.T) {
+			expected, _, err := listIds(ctx, db, model.ImportTypeListOptions{SortBy: sortBy})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			actual := []string{}
+			options := model.ImportTypeListOptions{SortBy: sortBy, Limit: 2}
+			for page := 0; page < 10; page++ {
+				list, _, err := db.ListImportTypes(ctx, options)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				for _, element := range list {
+					actual = append(actual, element.Id)
+				}
+				next, err := model.NextImportTypeCursor(sortBy, options.Limit, list)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if next == "" {
+					break
+				}
+				cursor, err := model.DecodeImportTypeCursor(next)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				options.After = &cursor
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Error(actual, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func testRevisions(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	importTypes := fixtures()
+	start := time.Now().Add(-time.Second)
+
+	for i, author := range []string{"user1", "user2", "user1"} {
+		importType := importTypes[2]
+		importType.Description = author
+		revision, err := db.AddImportTypeRevision(ctx, author, importType)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if revision.Revision != int64(i+1) || revision.Author != author || revision.ImportTypeId != importType.Id || revision.Date.Before(start) {
+			t.Errorf("%#v", revision)
+			return
+		}
+	}
+	_, err := db.AddImportTypeRevision(ctx, "user1", importTypes[0])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	revisionNumbers := func(importTypeId string, listOptions model.ImportTypeRevisionListOptions) (result []int64, total int64, err error) {
+		list, total, err := db.ListImportTypeRevisions(ctx, importTypeId, listOptions)
+		if err != nil {
+			return result, total, err
+		}
+		result = []int64{}
+		for _, revision := range list {
+			result = append(result, revision.Revision)
+		}
+		return result, total, nil
+	}
+
+	t.Run("get", func(t *testing.T) {
+		revision, exists, err := db.GetImportTypeRevision(ctx, "c", 2)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := importTypes[2]
+		expected.Description = "user2"
+		if !exists || revision.Author != "user2" || !reflect.DeepEqual(revision.ImportType, expected) {
+			t.Errorf("\n%#v\n%#v", revision, expected)
+		}
+		_, exists, err = db.GetImportTypeRevision(ctx, "c", 4)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("unexpected revision")
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		for _, c := range []struct {
+			options  model.ImportTypeRevisionListOptions
+			expected []int64
+		}{
+			{options: model.ImportTypeRevisionListOptions{}, expected: []int64{3, 2, 1}},
+			{options: model.ImportTypeRevisionListOptions{Limit: 2}, expected: []int64{3, 2}},
+			{options: model.ImportTypeRevisionListOptions{Limit: 2, Offset: 2}, expected: []int64{1}},
+			{options: model.ImportTypeRevisionListOptions{Offset: 5}, expected: []int64{}},
+		} {
+			actual, total, err := revisionNumbers("c", c.options)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !reflect.DeepEqual(actual, c.expected) || total != 3 {
+				t.Error(c.options, actual, total)
+			}
+		}
+	})
+
+	t.Run("remove revision", func(t *testing.T) {
+		err := db.RemoveImportTypeRevision(ctx, "c", 2)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		actual, total, err := revisionNumbers("c", model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(actual, []int64{3, 1}) || total != 2 {
+			t.Error(actual, total)
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := db.RemoveImportTypeRevisions(ctx, "c")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		actual, total, err := revisionNumbers("c", model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(actual) != 0 || total != 0 {
+			t.Error(actual, total)
+		}
+		actual, total, err = revisionNumbers("a", model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(actual, []int64{1}) || total != 1 {
+			t.Error(actual, total)
+		}
+		// numbering restarts after removal
+		revision, err := db.AddImportTypeRevision(ctx, "user1", importTypes[2])
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if revision.Revision != 1 {
+			t.Error(revision.Revision)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func testTransaction(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	importTypes := fixtures()
+	err := db.SetImportType(ctx, importTypes[0])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("rollback", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		err := db.Transaction(ctx, func(ctx context.Context) error {
+			err := db.SetImportType(ctx, importTypes[1])
+			if err != nil {
+				return err
+			}
+			_, err = db.AddImportTypeRevision(ctx, "user", importTypes[1])
+			if err != nil {
+				return err
+			}
+			err = db.RemoveImportType(ctx, importTypes[0].Id)
+			if err != nil {
+				return err
+			}
+			// writes of the transaction are visible inside of it
+			_, exists, err := db.GetImportType(ctx, importTypes[1].Id)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return errors.New("missing import type in transaction")
+			}
+			return expectedErr
+		})
+		if !errors.Is(err, expectedErr) {
+			t.Error(err)
+			return
+		}
+		ids, _, err := listIds(ctx, db, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(ids) != 1 || ids[0] != importTypes[0].Id {
+			t.Error(ids)
+		}
+		_, total, err := db.ListImportTypeRevisions(ctx, importTypes[1].Id, model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 0 {
+			t.Error(total)
+		}
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		err := db.Transaction(ctx, func(ctx context.Context) error {
+			err := db.SetImportTypeIfMatch(ctx, importTypes[0], withEtag(importTypes[0]).Etag)
+			if err != nil {
+				return err
+			}
+			return db.SetImportType(ctx, importTypes[1])
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		ids, _, err := listIds(ctx, db, model.ImportTypeListOptions{SortBy: "id.asc"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(ids) != 2 || ids[0] != importTypes[0].Id || ids[1] != importTypes[1].Id {
+			t.Error(ids)
+		}
+	})
+
+	t.Run("precondition in transaction", func(t *testing.T) {
+		err := db.Transaction(ctx, func(ctx context.Context) error {
+			err := db.SetImportType(ctx, importTypes[2])
+			if err != nil {
+				return err
+			}
+			return db.RemoveImportTypeIfMatch(ctx, importTypes[0].Id, "wrong")
+		})
+		if !errors.Is(err, model.ErrPreconditionFailed) {
+			t.Error(err)
+			return
+		}
+		_, exists, err := db.GetImportType(ctx, importTypes[2].Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("import type of failed transaction stored")
+		}
+	})
+
+	t.Run("nested rollback", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		err := db.Transaction(ctx, func(ctx context.Context) error {
+			err := db.SetImportType(ctx, importTypes[2])
+			if err != nil {
+				return err
+			}
+			return db.Transaction(ctx, func(ctx context.Context) error {
+				_, err := db.AddImportTypeRevision(ctx, "user", importTypes[2])
+				if err != nil {
+					return err
+				}
+				return expectedErr
+			})
+		})
+		if !errors.Is(err, expectedErr) {
+			t.Error(err)
+			return
+		}
+		_, exists, err := db.GetImportType(ctx, importTypes[2].Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("import type of failed transaction stored")
+		}
+		_, total, err := db.ListImportTypeRevisions(ctx, importTypes[2].Id, model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 0 {
+			t.Error(total)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package databasetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+func testTrash(t *testing.T, db database.Database) {
+	ctx := context.Background()
+	importTypes := fixtures()
+	deletedAt := time.Now().UTC().Truncate(time.Millisecond)
+	administrate := permV2Model.PermissionsMap{Read: true, Write: true, Execute: true, Administrate: true}
+	read := permV2Model.PermissionsMap{Read: true}
+
+	entries := []model.TrashedImportType{
+		{
+			ImportType:  withEtag(importTypes[0]),
+			Permissions: permV2Model.ResourcePermissions{UserPermissions: map[string]permV2Model.PermissionsMap{"u1": administrate}},
+			DeletedBy:   "u1",
+			DeletedAt:   deletedAt.Add(-time.Hour),
+		},
+		{
+			ImportType:  importTypes[1],
+			Permissions: permV2Model.ResourcePermissions{GroupPermissions: map[string]permV2Model.PermissionsMap{"g1": administrate}},
+			DeletedBy:   "u2",
+			DeletedAt:   deletedAt,
+		},
+		{
+			ImportType: importTypes[2],
+			Permissions: permV2Model.ResourcePermissions{
+				UserPermissions: map[string]permV2Model.PermissionsMap{"u2": read},
+				RolePermissions: map[string]permV2Model.PermissionsMap{"r1": administrate},
+			},
+			DeletedBy: "u2",
+			DeletedAt: deletedAt,
+		},
+	}
+	for _, entry := range entries {
+		err := db.SetTrashedImportType(ctx, entry)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	// the replacement does not change the deletion date, to keep the expected order
+	entries[0].DeletedBy = "u3"
+	err := db.SetTrashedImportType(ctx, entries[0])
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	entries[0].ImportType.Etag = ""
+
+	t.Run("get", func(t *testing.T) {
+		actual, exists, err := db.GetTrashedImportType(ctx, "a")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !exists || !reflect.DeepEqual(actual, entries[0]) {
+			t.Errorf("\n%#v\n%#v", actual, entries[0])
+		}
+		_, exists, err = db.GetTrashedImportType(ctx, "d")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("unexpected trash entry")
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		for _, c := range []struct {
+			name     string
+			options  model.TrashListOptions
+			expected []string
+			total    int64
+		}{
+			{name: "all", options: model.TrashListOptions{}, expected: []string{"b", "c", "a"}, total: 3},
+			{name: "limit and offset", options: model.TrashListOptions{Limit: 1, Offset: 1}, expected: []string{"c"}, total: 3},
+			{name: "deleted before", options: model.TrashListOptions{DeletedBefore: deletedAt}, expected: []string{"a"}, total: 1},
+			{name: "administrator by group", options: model.TrashListOptions{Administrator: &model.TrashAdministrator{UserId: "u2", Groups: []string{"g1"}}}, expected: []string{"b"}, total: 1},
+			{name: "administrator by user and role", options: model.TrashListOptions{Administrator: &model.TrashAdministrator{UserId: "u1", Roles: []string{"r1"}}}, expected: []string{"c", "a"}, total: 2},
+			{name: "no administrator", options: model.TrashListOptions{Administrator: &model.TrashAdministrator{UserId: "u4"}}, expected: []string{}, total: 0},
+		} {
+			t.Run(c.name, func(t *testing.T) {
+				list, total, err := db.ListTrashedImportTypes(ctx, c.options)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				actual := []string{}
+				for _, entry := range list {
+					actual = append(actual, entry.ImportType.Id)
+				}
+				if !reflect.DeepEqual(actual, c.expected) || total != c.total {
+					t.Error(actual, total)
+				}
+			})
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := db.RemoveTrashedImportType(ctx, "a")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, exists, err := db.GetTrashedImportType(ctx, "a")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if exists {
+			t.Error("trash entry not removed")
+		}
+	})
+}
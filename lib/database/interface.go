@@ -24,6 +24,30 @@ import (
 type Database interface {
 	GetImportType(ctx context.Context, id string) (device model.ImportType, exists bool, err error)
 	ListImportTypes(ctx context.Context, options model.ImportTypeListOptions) (result []model.ImportType, total int64, err error)
+	GetImportTypeFacets(ctx context.Context, options model.ImportTypeListOptions) (result model.ImportTypeFacets, err error)
+	ListImportTypeTags(ctx context.Context, options model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error)
 	SetImportType(ctx context.Context, importType model.ImportType) error
+	SetImportTypeIfMatch(ctx context.Context, importType model.ImportType, etag string) error
 	RemoveImportType(ctx context.Context, id string) error
+	RemoveImportTypeIfMatch(ctx context.Context, id string, etag string) error
+
+	AddImportTypeRevision(ctx context.Context, author string, importType model.ImportType) (revision model.ImportTypeRevision, err error)
+	GetImportTypeRevision(ctx context.Context, importTypeId string, revision int64) (result model.ImportTypeRevision, exists bool, err error)
+	ListImportTypeRevisions(ctx context.Context, importTypeId string, options model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error)
+	RemoveImportTypeRevision(ctx context.Context, importTypeId string, revision int64) error
+	RemoveImportTypeRevisions(ctx context.Context, importTypeId string) error
+
+	SetTrashedImportType(ctx context.Context, trashed model.TrashedImportType) error
+	GetTrashedImportType(ctx context.Context, id string) (result model.TrashedImportType, exists bool, err error)
+	ListTrashedImportTypes(ctx context.Context, options model.TrashListOptions) (result []model.TrashedImportType, total int64, err error)
+	RemoveTrashedImportType(ctx context.Context, id string) error
+
+	SetImportTypeCategory(ctx context.Context, category model.ImportTypeCategory) error
+	GetImportTypeCategory(ctx context.Context, id string) (result model.ImportTypeCategory, exists bool, err error)
+	ListImportTypeCategories(ctx context.Context) (result []model.ImportTypeCategory, err error)
+	RemoveImportTypeCategory(ctx context.Context, id string) error
+
+	// Transaction executes f atomically; database calls inside f have to use the context passed to f
+	// f may be called multiple times if the transaction is retried
+	Transaction(ctx context.Context, f func(ctx context.Context) error) error
 }
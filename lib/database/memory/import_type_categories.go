@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// SetImportTypeCategory creates or replaces the category
+func (this *Memory) SetImportTypeCategory(ctx context.Context, category model.ImportTypeCategory) error {
+	defer this.lock(ctx)()
+	this.state.categories[category.Id] = category
+	return nil
+}
+
+func (this *Memory) GetImportTypeCategory(ctx context.Context, id string) (result model.ImportTypeCategory, exists bool, err error) {
+	defer this.rlock(ctx)()
+	result, exists = this.state.categories[id]
+	return result, exists, nil
+}
+
+// ListImportTypeCategories returns all categories, ordered by name
+func (this *Memory) ListImportTypeCategories(ctx context.Context) (result []model.ImportTypeCategory, err error) {
+	defer this.rlock(ctx)()
+	result = slices.Collect(maps.Values(this.state.categories))
+	if result == nil {
+		result = []model.ImportTypeCategory{}
+	}
+	query.SortCategories(result)
+	return result, nil
+}
+
+func (this *Memory) RemoveImportTypeCategory(ctx context.Context, id string) error {
+	defer this.lock(ctx)()
+	delete(this.state.categories, id)
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func (this *Memory) AddImportTypeRevision(ctx context.Context, author string, importType model.ImportType) (result model.ImportTypeRevision, err error) {
+	defer this.lock(ctx)()
+	revisions := this.state.revisions[importType.Id]
+	snapshot := query.NormalizeImportType(importType)
+	snapshot.Etag = ""
+	latest := int64(0)
+	if len(revisions) > 0 {
+		last, err := decode[model.ImportTypeRevision](revisions[len(revisions)-1])
+		if err != nil {
+			return result, err
+		}
+		latest = last.Revision
+	}
+	result = model.ImportTypeRevision{
+		ImportTypeId: importType.Id,
+		Revision:     latest + 1,
+		Author:       author,
+		Date:         query.NormalizeTime(time.Now()),
+		ImportType:   snapshot,
+	}
+	value, err := encode(result)
+	if err != nil {
+		return result, err
+	}
+	// revisions are appended in ascending order; the stored slice is not modified in place to keep transaction snapshots intact
+	this.state.revisions[importType.Id] = append(slices.Clip(revisions), value)
+	result.ImportType = importType
+	return result, nil
+}
+
+func (this *Memory) GetImportTypeRevision(ctx context.Context, importTypeId string, revision int64) (result model.ImportTypeRevision, exists bool, err error) {
+	revisions, err := this.revisions(ctx, importTypeId)
+	if err != nil {
+		return result, false, err
+	}
+	for _, element := range revisions {
+		if element.Revision == revision {
+			return element, true, nil
+		}
+	}
+	return result, false, nil
+}
+
+func (this *Memory) ListImportTypeRevisions(ctx context.Context, importTypeId string, listOptions model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error) {
+	revisions, err := this.revisions(ctx, importTypeId)
+	if err != nil {
+		return result, total, err
+	}
+	result, total = query.ListRevisions(revisions, listOptions)
+	return result, total, nil
+}
+
+func (this *Memory) RemoveImportTypeRevision(ctx context.Context, importTypeId string, revision int64) error {
+	defer this.lock(ctx)()
+	remaining := [][]byte{}
+	for _, value := range this.state.revisions[importTypeId] {
+		element, err := decode[model.ImportTypeRevision](value)
+		if err != nil {
+			return err
+		}
+		if element.Revision != revision {
+			remaining = append(remaining, value)
+		}
+	}
+	// a new slice is stored to keep transaction snapshots intact
+	this.state.revisions[importTypeId] = remaining
+	return nil
+}
+
+func (this *Memory) RemoveImportTypeRevisions(ctx context.Context, importTypeId string) error {
+	defer this.lock(ctx)()
+	delete(this.state.revisions, importTypeId)
+	return nil
+}
+
+func (this *Memory) revisions(ctx context.Context, importTypeId string) (result []model.ImportTypeRevision, err error) {
+	defer this.rlock(ctx)()
+	result = []model.ImportTypeRevision{}
+	for _, value := range this.state.revisions[importTypeId] {
+		revision, err := decode[model.ImportTypeRevision](value)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, revision)
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// SetTrashedImportType stores the trash entry; an existing entry of the same import type is replaced
+func (this *Memory) SetTrashedImportType(ctx context.Context, trashed model.TrashedImportType) error {
+	trashed.ImportType = query.NormalizeImportType(trashed.ImportType)
+	trashed.ImportType.Etag = ""
+	trashed.DeletedAt = query.NormalizeTime(trashed.DeletedAt)
+	value, err := encode(trashed)
+	if err != nil {
+		return err
+	}
+	defer this.lock(ctx)()
+	this.state.trash[trashed.ImportType.Id] = value
+	return nil
+}
+
+func (this *Memory) GetTrashedImportType(ctx context.Context, id string) (result model.TrashedImportType, exists bool, err error) {
+	defer this.rlock(ctx)()
+	value, exists := this.state.trash[id]
+	if !exists {
+		return result, false, nil
+	}
+	result, err = decode[model.TrashedImportType](value)
+	return result, true, err
+}
+
+// ListTrashedImportTypes returns trash entries, newest deletion first
+func (this *Memory) ListTrashedImportTypes(ctx context.Context, listOptions model.TrashListOptions) (result []model.TrashedImportType, total int64, err error) {
+	entries, err := this.trashedImportTypes(ctx)
+	if err != nil {
+		return result, total, err
+	}
+	result, total = query.ListTrash(entries, listOptions)
+	return result, total, nil
+}
+
+func (this *Memory) RemoveTrashedImportType(ctx context.Context, id string) error {
+	defer this.lock(ctx)()
+	delete(this.state.trash, id)
+	return nil
+}
+
+func (this *Memory) trashedImportTypes(ctx context.Context) (result []model.TrashedImportType, err error) {
+	defer this.rlock(ctx)()
+	result = make([]model.TrashedImportType, 0, len(this.state.trash))
+	for _, value := range this.state.trash {
+		entry, err := decode[model.TrashedImportType](value)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
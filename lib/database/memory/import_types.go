@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database/query"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func (this *Memory) GetImportType(ctx context.Context, id string) (importType model.ImportType, exists bool, err error) {
+	defer this.rlock(ctx)()
+	stored, exists := this.state.importTypes[id]
+	if !exists {
+		return importType, false, nil
+	}
+	importType, err = decodeImportType(stored)
+	return importType, true, err
+}
+
+func (this *Memory) ListImportTypes(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportType, total int64, err error) {
+	importTypes, err := this.importTypes(ctx)
+	if err != nil {
+		return result, total, err
+	}
+	return query.List(importTypes, listOptions)
+}
+
+func (this *Memory) GetImportTypeFacets(ctx context.Context, listOptions model.ImportTypeListOptions) (result model.ImportTypeFacets, err error) {
+	importTypes, err := this.importTypes(ctx)
+	if err != nil {
+		return result, err
+	}
+	return query.Facets(importTypes, listOptions), nil
+}
+
+// ListImportTypeTags counts the import types matching the search, criteria, tags, categories and ids of the options per tag.
+// the result is sorted by count (descending) and tag.
+func (this *Memory) ListImportTypeTags(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error) {
+	importTypes, err := this.importTypes(ctx)
+	if err != nil {
+		return result, err
+	}
+	return query.Tags(importTypes, listOptions), nil
+}
+
+// importTypes decodes all stored import types, with etag
+func (this *Memory) importTypes(ctx context.Context) (result []model.ImportType, err error) {
+	defer this.rlock(ctx)()
+	result = make([]model.ImportType, 0, len(this.state.importTypes))
+	for _, stored := range this.state.importTypes {
+		importType, err := decodeImportType(stored)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, importType)
+	}
+	return result, nil
+}
+
+func (this *Memory) SetImportType(ctx context.Context, importType model.ImportType) error {
+	stored, err := encodeImportType(importType)
+	if err != nil {
+		return err
+	}
+	defer this.lock(ctx)()
+	this.state.importTypes[importType.Id] = stored
+	return nil
+}
+
+// SetImportTypeIfMatch replaces the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Memory) SetImportTypeIfMatch(ctx context.Context, importType model.ImportType, etag string) error {
+	stored, err := encodeImportType(importType)
+	if err != nil {
+		return err
+	}
+	defer this.lock(ctx)()
+	existing, exists := this.state.importTypes[importType.Id]
+	if !exists || existing.etag != etag {
+		return model.ErrPreconditionFailed
+	}
+	this.state.importTypes[importType.Id] = stored
+	return nil
+}
+
+func (this *Memory) RemoveImportType(ctx context.Context, id string) error {
+	defer this.lock(ctx)()
+	delete(this.state.importTypes, id)
+	return nil
+}
+
+// RemoveImportTypeIfMatch removes the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Memory) RemoveImportTypeIfMatch(ctx context.Context, id string, etag string) error {
+	defer this.lock(ctx)()
+	existing, exists := this.state.importTypes[id]
+	if !exists || existing.etag != etag {
+		return model.ErrPreconditionFailed
+	}
+	delete(this.state.importTypes, id)
+	return nil
+}
+
+func encodeImportType(importType model.ImportType) (stored storedImportType, err error) {
+	stored.etag, err = model.ImportTypeEtag(importType)
+	if err != nil {
+		return stored, err
+	}
+	importType = query.NormalizeImportType(importType)
+	importType.Etag = ""
+	stored.value, err = encode(importType)
+	return stored, err
+}
+
+func decodeImportType(stored storedImportType) (importType model.ImportType, err error) {
+	importType, err = decode[model.ImportType](stored.value)
+	importType.Etag = stored.etag
+	return importType, err
+}
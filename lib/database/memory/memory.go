@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"sync"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/google/uuid"
+)
+
+// Memory implements database.Database without external dependencies, for tests and local development.
+// all data is lost if the process stops.
+type Memory struct {
+	mux   sync.RWMutex
+	state state
+}
+
+// state holds encoded copies of all stored values, so that callers can neither modify stored values nor share them with other callers.
+// the values are encoded as json, which normalizes them like the round trip through mongodb (e.g. numbers of untyped config defaults become float64).
+type state struct {
+	importTypes map[string]storedImportType
+	revisions   map[string][][]byte //by import type id
+	trash       map[string][]byte   //by import type id
+	categories  map[string]model.ImportTypeCategory
+}
+
+type storedImportType struct {
+	value []byte
+	etag  string
+}
+
+func New() *Memory {
+	return &Memory{state: state{
+		importTypes: map[string]storedImportType{},
+		revisions:   map[string][][]byte{},
+		trash:       map[string][]byte{},
+		categories:  map[string]model.ImportTypeCategory{},
+	}}
+}
+
+func (this *Memory) CreateId() string {
+	return uuid.NewString()
+}
+
+type transactionKey struct{}
+
+// lock acquires the write lock, unless ctx belongs to a transaction of this database, which already holds it
+func (this *Memory) lock(ctx context.Context) (unlock func()) {
+	if ctx.Value(transactionKey{}) == this {
+		return func() {}
+	}
+	this.mux.Lock()
+	return this.mux.Unlock
+}
+
+// rlock acquires the read lock, unless ctx belongs to a transaction of this database, which already holds the write lock
+func (this *Memory) rlock(ctx context.Context) (unlock func()) {
+	if ctx.Value(transactionKey{}) == this {
+		return func() {}
+	}
+	this.mux.RLock()
+	return this.mux.RUnlock
+}
+
+// Transaction executes f while holding the write lock and restores the previous state if f returns an error.
+// nested transactions are part of the outer transaction; database calls of f must not be executed concurrently.
+func (this *Memory) Transaction(ctx context.Context, f func(ctx context.Context) error) error {
+	if ctx.Value(transactionKey{}) == this {
+		return f(ctx)
+	}
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	// stored values are never modified in place, so copying the maps is sufficient for a snapshot
+	snapshot := state{
+		importTypes: maps.Clone(this.state.importTypes),
+		revisions:   maps.Clone(this.state.revisions),
+		trash:       maps.Clone(this.state.trash),
+		categories:  maps.Clone(this.state.categories),
+	}
+	err := f(context.WithValue(ctx, transactionKey{}, this))
+	if err != nil {
+		this.state = snapshot
+	}
+	return err
+}
+
+func encode(value any) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func decode[T any](value []byte) (result T, err error) {
+	err = json.Unmarshal(value, &result)
+	return result, err
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/database/databasetest"
+	"github.com/SENERGY-Platform/import-repository/lib/database/memory"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestConformance(t *testing.T) {
+	databasetest.Run(t, func(t *testing.T) database.Database {
+		return memory.New()
+	})
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	db := memory.New()
+	ctx := context.Background()
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 100)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := "it-" + strconv.Itoa(i%5)
+			for j := 0; j < 20; j++ {
+				err := db.Transaction(ctx, func(ctx context.Context) error {
+					existing, exists, err := db.GetImportType(ctx, id)
+					if err != nil {
+						return err
+					}
+					importType := model.ImportType{Id: id, Name: id, Cost: existing.Cost + 1}
+					if !exists {
+						return db.SetImportType(ctx, importType)
+					}
+					return db.SetImportTypeIfMatch(ctx, importType, existing.Etag)
+				})
+				if err != nil {
+					errs <- err
+					return
+				}
+				_, _, err = db.ListImportTypes(ctx, model.ImportTypeListOptions{Search: "it"})
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// every transaction incremented the cost of its import type exactly once
+	list, _, err := db.ListImportTypes(ctx, model.ImportTypeListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, importType := range list {
+		if importType.Cost != 80 {
+			t.Error(importType.Id, importType.Cost)
+		}
+	}
+	if len(list) != 5 {
+		t.Error(len(list))
+	}
+}
+
+func TestTransactionPanic(t *testing.T) {
+	db := memory.New()
+	ctx := context.Background()
+	func() {
+		defer func() {
+			_ = recover()
+		}()
+		_ = db.Transaction(ctx, func(ctx context.Context) error {
+			panic(errors.New("test"))
+		})
+	}()
+	// the lock has to be released after a panic
+	err := db.SetImportType(ctx, model.ImportType{Id: "foo"})
+	if err != nil {
+		t.Error(err)
+	}
+}
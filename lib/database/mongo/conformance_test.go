@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/database/databasetest"
+	"github.com/SENERGY-Platform/import-repository/lib/database/mongo"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/docker"
+)
+
+func TestConformance(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../../../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, ip, err := docker.MongoDB(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.MongoUrl = "mongodb://" + ip + ":27017"
+
+	count := 0
+	databasetest.Run(t, func(t *testing.T) database.Database {
+		// every test uses its own mongodb database, to start empty
+		count++
+		testConf := conf
+		testConf.MongoTable = conf.MongoTable + "_" + strconv.Itoa(count)
+		db, err := mongo.New(testConf, ctx, wg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	})
+}
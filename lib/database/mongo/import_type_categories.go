@@ -0,0 +1,87 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var categoryIdKey string
+var categoryNameKey string
+
+func init() {
+	var err error
+	categoryIdKey, err = getBsonFieldName(model.ImportTypeCategory{}, "Id")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for category id", attributes.ErrorKey, err)
+		panic(err)
+	}
+	categoryNameKey, err = getBsonFieldName(model.ImportTypeCategory{}, "Name")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for category name", attributes.ErrorKey, err)
+		panic(err)
+	}
+
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		return db.ensureIndex(db.importTypeCategoryCollection(), "importTypeCategoryIdIndex", categoryIdKey, true, true)
+	})
+}
+
+func (this *Mongo) importTypeCategoryCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoImportTypeCategoryCollection)
+}
+
+// SetImportTypeCategory creates or replaces the category
+func (this *Mongo) SetImportTypeCategory(ctx context.Context, category model.ImportTypeCategory) error {
+	_, err := this.importTypeCategoryCollection().ReplaceOne(ctx, bson.M{categoryIdKey: category.Id}, category, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) GetImportTypeCategory(ctx context.Context, id string) (result model.ImportTypeCategory, exists bool, err error) {
+	err = this.importTypeCategoryCollection().FindOne(ctx, bson.M{categoryIdKey: id}).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return result, false, nil
+	}
+	if err != nil {
+		return result, false, err
+	}
+	return result, true, nil
+}
+
+// ListImportTypeCategories returns all categories, ordered by name
+func (this *Mongo) ListImportTypeCategories(ctx context.Context) (result []model.ImportTypeCategory, err error) {
+	cursor, err := this.importTypeCategoryCollection().Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: categoryNameKey, Value: 1}, {Key: categoryIdKey, Value: 1}}))
+	if err != nil {
+		return result, err
+	}
+	result = []model.ImportTypeCategory{}
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func (this *Mongo) RemoveImportTypeCategory(ctx context.Context, id string) error {
+	_, err := this.importTypeCategoryCollection().DeleteOne(ctx, bson.M{categoryIdKey: id})
+	return err
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var ownerKey string
+var costKey string
+
+func init() {
+	var err error
+	ownerKey, err = getBsonFieldName(model.ImportType{}, "Owner")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for import type owner", attributes.ErrorKey, err)
+		panic(err)
+	}
+	costKey, err = getBsonFieldName(model.ImportType{}, "Cost")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for import type cost", attributes.ErrorKey, err)
+		panic(err)
+	}
+}
+
+type facetGroup struct {
+	Value string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+type costGroup struct {
+	Min   int64 `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+type facetAggregation struct {
+	Functions       []facetGroup `bson:"functions"`
+	Aspects         []facetGroup `bson:"aspects"`
+	Characteristics []facetGroup `bson:"characteristics"`
+	Owners          []facetGroup `bson:"owners"`
+	Costs           []costGroup  `bson:"costs"`
+}
+
+// facetCounts collects the counts of one or more aggregations; the id chunks of a query are disjoint, so counts can be summed
+type facetCounts struct {
+	functions       map[string]int64
+	aspects         map[string]int64
+	characteristics map[string]int64
+	owners          map[string]int64
+	costs           map[uint64]int64
+}
+
+func (this *Mongo) GetImportTypeFacets(ctx context.Context, listOptions model.ImportTypeListOptions) (result model.ImportTypeFacets, err error) {
+	filter, _ := importTypeListFilter(listOptions)
+	counts := facetCounts{
+		functions:       map[string]int64{},
+		aspects:         map[string]int64{},
+		characteristics: map[string]int64{},
+		owners:          map[string]int64{},
+		costs:           map[uint64]int64{},
+	}
+	if listOptions.Ids == nil {
+		err = this.aggregateImportTypeFacets(ctx, filter, counts)
+		if err != nil {
+			return result, err
+		}
+	}
+	for _, chunk := range chunkIds(listOptions.Ids, maxIdsPerQuery) {
+		filter[idKey] = bson.M{"$in": chunk}
+		err = this.aggregateImportTypeFacets(ctx, filter, counts)
+		if err != nil {
+			return result, err
+		}
+	}
+	result = model.ImportTypeFacets{
+		Functions:       sortedFacetCounts(counts.functions),
+		Aspects:         sortedFacetCounts(counts.aspects),
+		Characteristics: sortedFacetCounts(counts.characteristics),
+		Owners:          sortedFacetCounts(counts.owners),
+		Costs:           []model.CostBucketCount{},
+	}
+	for i, lower := range model.CostBuckets {
+		bucket := model.CostBucketCount{Min: lower, Count: counts.costs[lower]}
+		if i+1 < len(model.CostBuckets) {
+			upper := model.CostBuckets[i+1]
+			bucket.Max = &upper
+		}
+		result.Costs = append(result.Costs, bucket)
+	}
+	return result, nil
+}
+
+func (this *Mongo) aggregateImportTypeFacets(ctx context.Context, filter bson.M, counts facetCounts) error {
+	boundaries := []int64{}
+	for _, lower := range model.CostBuckets {
+		boundaries = append(boundaries, int64(lower))
+	}
+	// costs above the last boundary are collected in the default bucket, which uses the last boundary as id
+	pipeline := []bson.M{
+		{"$match": filter},
+		{"$facet": bson.M{
+			"functions":       criteriaFacetPipeline("function_id"),
+			"aspects":         criteriaFacetPipeline("aspect_id"),
+			"characteristics": criteriaFacetPipeline("characteristic_id"),
+			"owners": []bson.M{
+				{"$match": bson.M{ownerKey: bson.M{"$nin": []interface{}{"", nil}}}},
+				{"$group": bson.M{"_id": "$" + ownerKey, "count": bson.M{"$sum": 1}}},
+			},
+			"costs": []bson.M{
+				{"$bucket": bson.M{"groupBy": "$" + costKey, "boundaries": boundaries, "default": boundaries[len(boundaries)-1]}},
+			},
+		}},
+	}
+	cursor, err := this.importTypeCollection().Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	aggregations := []facetAggregation{}
+	err = cursor.All(ctx, &aggregations)
+	if err != nil {
+		return err
+	}
+	for _, aggregation := range aggregations {
+		addFacetGroups(counts.functions, aggregation.Functions)
+		addFacetGroups(counts.aspects, aggregation.Aspects)
+		addFacetGroups(counts.characteristics, aggregation.Characteristics)
+		addFacetGroups(counts.owners, aggregation.Owners)
+		for _, group := range aggregation.Costs {
+			counts.costs[uint64(group.Min)] += group.Count
+		}
+	}
+	return nil
+}
+
+// criteriaFacetPipeline counts the import types per value of a criteria field; every import type is counted once per value
+func criteriaFacetPipeline(field string) []bson.M {
+	return []bson.M{
+		{"$unwind": "$criteria"},
+		{"$match": bson.M{"criteria." + field: bson.M{"$nin": []interface{}{"", nil}}}},
+		{"$group": bson.M{"_id": bson.M{"doc": "$_id", "value": "$criteria." + field}}},
+		{"$group": bson.M{"_id": "$_id.value", "count": bson.M{"$sum": 1}}},
+	}
+}
+
+func addFacetGroups(counts map[string]int64, groups []facetGroup) {
+	for _, group := range groups {
+		counts[group.Value] += group.Count
+	}
+}
+
+func sortedFacetCounts(counts map[string]int64) []model.FacetCount {
+	result := []model.FacetCount{}
+	for value, count := range counts {
+		result = append(result, model.FacetCount{Value: value, Count: count})
+	}
+	slices.SortFunc(result, func(a, b model.FacetCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Value, b.Value)
+	})
+	return result
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const revisionImportTypeIdFieldName = "ImportTypeId"
+const revisionFieldName = "Revision"
+
+var revisionImportTypeIdKey string
+var revisionKey string
+
+// maxRevisionInsertAttempts limits retries if concurrent writers try to claim the same revision number
+const maxRevisionInsertAttempts = 5
+
+func init() {
+	var err error
+	revisionImportTypeIdKey, err = getBsonFieldName(model.ImportTypeRevision{}, revisionImportTypeIdFieldName)
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for revision import type id", attributes.ErrorKey, err)
+		panic(err)
+	}
+	revisionKey, err = getBsonFieldName(model.ImportTypeRevision{}, revisionFieldName)
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for revision", attributes.ErrorKey, err)
+		panic(err)
+	}
+
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.importTypeRevisionCollection()
+		return db.ensureCompoundIndex(collection, "importTypeRevisionIndex", true, true, revisionImportTypeIdKey, revisionKey)
+	})
+}
+
+func (this *Mongo) importTypeRevisionCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoImportTypeRevisionCollection)
+}
+
+func (this *Mongo) AddImportTypeRevision(ctx context.Context, author string, importType model.ImportType) (result model.ImportTypeRevision, err error) {
+	snapshot, err := importTypeToWrite(importType)
+	if err != nil {
+		return result, err
+	}
+	for attempt := 0; attempt < maxRevisionInsertAttempts; attempt++ {
+		var latest int64
+		latest, err = this.getLatestRevisionNumber(ctx, importType.Id)
+		if err != nil {
+			return result, err
+		}
+		result = model.ImportTypeRevision{
+			ImportTypeId: importType.Id,
+			Revision:     latest + 1,
+			Author:       author,
+			Date:         time.Now().UTC().Truncate(time.Millisecond),
+			ImportType:   snapshot,
+		}
+		_, err = this.importTypeRevisionCollection().InsertOne(ctx, result)
+		if err == nil {
+			result.ImportType = importType
+			return result, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+func (this *Mongo) getLatestRevisionNumber(ctx context.Context, importTypeId string) (revision int64, err error) {
+	latest := model.ImportTypeRevision{}
+	err = this.importTypeRevisionCollection().FindOne(ctx, bson.M{revisionImportTypeIdKey: importTypeId}, options.FindOne().SetSort(bson.D{{Key: revisionKey, Value: -1}})).Decode(&latest)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return latest.Revision, nil
+}
+
+func (this *Mongo) GetImportTypeRevision(ctx context.Context, importTypeId string, revision int64) (result model.ImportTypeRevision, exists bool, err error) {
+	err = this.importTypeRevisionCollection().FindOne(ctx, bson.M{revisionImportTypeIdKey: importTypeId, revisionKey: revision}).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return result, false, nil
+	}
+	if err != nil {
+		return result, false, err
+	}
+	err = importTypeToRead(&result.ImportType)
+	return result, true, err
+}
+
+func (this *Mongo) ListImportTypeRevisions(ctx context.Context, importTypeId string, listOptions model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64, err error) {
+	opt := options.Find().SetSort(bson.D{{Key: revisionKey, Value: -1}})
+	if listOptions.Limit > 0 {
+		opt.SetLimit(listOptions.Limit)
+	}
+	if listOptions.Offset > 0 {
+		opt.SetSkip(listOptions.Offset)
+	}
+	filter := bson.M{revisionImportTypeIdKey: importTypeId}
+	cursor, err := this.importTypeRevisionCollection().Find(ctx, filter, opt)
+	if err != nil {
+		return result, total, err
+	}
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return result, total, err
+	}
+	if result == nil {
+		result = []model.ImportTypeRevision{}
+	}
+	for i := range result {
+		err = importTypeToRead(&result[i].ImportType)
+		if err != nil {
+			return result, total, err
+		}
+	}
+	total, err = this.importTypeRevisionCollection().CountDocuments(ctx, filter)
+	return result, total, err
+}
+
+func (this *Mongo) RemoveImportTypeRevision(ctx context.Context, importTypeId string, revision int64) error {
+	_, err := this.importTypeRevisionCollection().DeleteOne(ctx, bson.M{revisionImportTypeIdKey: importTypeId, revisionKey: revision})
+	return err
+}
+
+func (this *Mongo) RemoveImportTypeRevisions(ctx context.Context, importTypeId string) error {
+	_, err := this.importTypeRevisionCollection().DeleteMany(ctx, bson.M{revisionImportTypeIdKey: importTypeId})
+	return err
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ListImportTypeTags counts the import types matching the search, criteria, tags, categories and ids of the options per tag.
+// the result is sorted by count (descending) and tag.
+func (this *Mongo) ListImportTypeTags(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportTypeTagCount, err error) {
+	filter, _ := importTypeListFilter(listOptions)
+	counts := map[string]int64{}
+	if listOptions.Ids == nil {
+		err = this.aggregateImportTypeTags(ctx, filter, counts)
+		if err != nil {
+			return result, err
+		}
+	}
+	// the id chunks are disjoint, so the counts of each chunk can be summed
+	for _, chunk := range chunkIds(listOptions.Ids, maxIdsPerQuery) {
+		filter[idKey] = bson.M{"$in": chunk}
+		err = this.aggregateImportTypeTags(ctx, filter, counts)
+		if err != nil {
+			return result, err
+		}
+	}
+	result = []model.ImportTypeTagCount{}
+	for tag, count := range counts {
+		result = append(result, model.ImportTypeTagCount{Tag: tag, Count: count})
+	}
+	slices.SortFunc(result, func(a, b model.ImportTypeTagCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return result, nil
+}
+
+func (this *Mongo) aggregateImportTypeTags(ctx context.Context, filter bson.M, counts map[string]int64) error {
+	cursor, err := this.importTypeCollection().Aggregate(ctx, []bson.M{
+		{"$match": filter},
+		{"$unwind": "$" + tagsKey},
+		{"$group": bson.M{"_id": "$" + tagsKey, "count": bson.M{"$sum": 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	groups := []facetGroup{}
+	err = cursor.All(ctx, &groups)
+	if err != nil {
+		return err
+	}
+	addFacetGroups(counts, groups)
+	return nil
+}
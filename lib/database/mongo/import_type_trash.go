@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var trashIdKey string
+var trashDeletedAtKey string
+var trashUserPermissionsKey string
+var trashGroupPermissionsKey string
+var trashRolePermissionsKey string
+var trashAdministrateKey string
+
+func init() {
+	var err error
+	importTypeKey, err := getBsonFieldName(model.TrashedImportType{}, "ImportType")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for trashed import type", attributes.ErrorKey, err)
+		panic(err)
+	}
+	importTypeIdKey, err := getBsonFieldName(model.ImportType{}, idFieldName)
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for import type id", attributes.ErrorKey, err)
+		panic(err)
+	}
+	trashIdKey = importTypeKey + "." + importTypeIdKey
+	trashDeletedAtKey, err = getBsonFieldName(model.TrashedImportType{}, "DeletedAt")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for trash deletion date", attributes.ErrorKey, err)
+		panic(err)
+	}
+	permissionsKey, err := getBsonFieldName(model.TrashedImportType{}, "Permissions")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for trash permissions", attributes.ErrorKey, err)
+		panic(err)
+	}
+	for fieldName, key := range map[string]*string{
+		"UserPermissions":  &trashUserPermissionsKey,
+		"GroupPermissions": &trashGroupPermissionsKey,
+		"RolePermissions":  &trashRolePermissionsKey,
+	} {
+		*key, err = getBsonFieldName(permV2Model.ResourcePermissions{}, fieldName)
+		if err != nil {
+			log.Logger.Error("unable to get bson field name for trash permissions", attributes.ErrorKey, err)
+			panic(err)
+		}
+		*key = permissionsKey + "." + *key
+	}
+	trashAdministrateKey, err = getBsonFieldName(permV2Model.PermissionsMap{}, "Administrate")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for trash permissions", attributes.ErrorKey, err)
+		panic(err)
+	}
+
+	CreateCollections = append(CreateCollections, func(db *Mongo) error {
+		collection := db.importTypeTrashCollection()
+		err := db.ensureIndex(collection, "importTypeTrashIdIndex", trashIdKey, true, true)
+		if err != nil {
+			return err
+		}
+		return db.ensureIndex(collection, "importTypeTrashDeletedAtIndex", trashDeletedAtKey, true, false)
+	})
+}
+
+func (this *Mongo) importTypeTrashCollection() *mongo.Collection {
+	return this.client.Database(this.config.MongoTable).Collection(this.config.MongoImportTypeTrashCollection)
+}
+
+// SetTrashedImportType stores the trash entry; an existing entry of the same import type is replaced
+func (this *Mongo) SetTrashedImportType(ctx context.Context, trashed model.TrashedImportType) error {
+	var err error
+	trashed.ImportType, err = importTypeToWrite(trashed.ImportType)
+	if err != nil {
+		return err
+	}
+	trashed.ImportType.Etag = ""
+	_, err = this.importTypeTrashCollection().ReplaceOne(ctx, bson.M{trashIdKey: trashed.ImportType.Id}, trashed, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (this *Mongo) GetTrashedImportType(ctx context.Context, id string) (result model.TrashedImportType, exists bool, err error) {
+	err = this.importTypeTrashCollection().FindOne(ctx, bson.M{trashIdKey: id}).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return result, false, nil
+	}
+	if err != nil {
+		return result, false, err
+	}
+	err = importTypeToRead(&result.ImportType)
+	return result, true, err
+}
+
+// ListTrashedImportTypes returns trash entries, newest deletion first
+func (this *Mongo) ListTrashedImportTypes(ctx context.Context, listOptions model.TrashListOptions) (result []model.TrashedImportType, total int64, err error) {
+	opt := options.Find().SetSort(bson.D{{Key: trashDeletedAtKey, Value: -1}, {Key: trashIdKey, Value: 1}})
+	if listOptions.Limit > 0 {
+		opt.SetLimit(listOptions.Limit)
+	}
+	if listOptions.Offset > 0 {
+		opt.SetSkip(listOptions.Offset)
+	}
+	filter := bson.M{}
+	if !listOptions.DeletedBefore.IsZero() {
+		filter[trashDeletedAtKey] = bson.M{"$lt": listOptions.DeletedBefore}
+	}
+	if listOptions.Administrator != nil {
+		or := []bson.M{{trashUserPermissionsKey + "." + listOptions.Administrator.UserId + "." + trashAdministrateKey: true}}
+		for _, group := range listOptions.Administrator.Groups {
+			or = append(or, bson.M{trashGroupPermissionsKey + "." + group + "." + trashAdministrateKey: true})
+		}
+		for _, role := range listOptions.Administrator.Roles {
+			or = append(or, bson.M{trashRolePermissionsKey + "." + role + "." + trashAdministrateKey: true})
+		}
+		filter["$or"] = or
+	}
+	cursor, err := this.importTypeTrashCollection().Find(ctx, filter, opt)
+	if err != nil {
+		return result, total, err
+	}
+	err = cursor.All(ctx, &result)
+	if err != nil {
+		return result, total, err
+	}
+	if result == nil {
+		result = []model.TrashedImportType{}
+	}
+	for i := range result {
+		err = importTypeToRead(&result[i].ImportType)
+		if err != nil {
+			return result, total, err
+		}
+	}
+	total, err = this.importTypeTrashCollection().CountDocuments(ctx, filter)
+	return result, total, err
+}
+
+func (this *Mongo) RemoveTrashedImportType(ctx context.Context, id string) error {
+	_, err := this.importTypeTrashCollection().DeleteOne(ctx, bson.M{trashIdKey: id})
+	return err
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
@@ -33,31 +34,55 @@ import (
 
 const idFieldName = "Id"
 const nameFieldName = "Name"
+const etagKey = "etag"
 
 var idKey string
 var nameKey string
+var tagsKey string
+var categoryKey string
+
+// sortKeys maps model.SortFields to the bson keys of the stored import type
+var sortKeys = map[string]string{}
 
 type ImportTypeWithCriteria struct {
 	model.ImportType `bson:",inline" json:",inline"`
 	Criteria         []ImportTypeCriteria `json:"criteria" bson:"criteria"`
+	OutputNames      []string             `json:"output_names" bson:"output_names"` //flattened names of all output variables for the text index
+}
+
+// ImportTypeDocument is the stored representation of an import type
+type ImportTypeDocument struct {
+	ImportTypeWithCriteria `bson:",inline"`
+	Etag                   string `bson:"etag"`
 }
 
 type ImportTypeCriteria struct {
-	FunctionId string `json:"function_id" bson:"function_id"`
-	AspectId   string `json:"aspect_id" bson:"aspect_id"`
+	FunctionId       string `json:"function_id" bson:"function_id"`
+	AspectId         string `json:"aspect_id" bson:"aspect_id"`
+	CharacteristicId string `json:"characteristic_id" bson:"characteristic_id"`
 }
 
 func importTypeWithCriteria(importType model.ImportType) ImportTypeWithCriteria {
 	return ImportTypeWithCriteria{
-		ImportType: importType,
-		Criteria:   contentVariableToCertList(importType.Output),
+		ImportType:  importType,
+		Criteria:    contentVariableToCertList(importType.Output),
+		OutputNames: contentVariableNames(importType.Output),
 	}
 }
 
+func contentVariableNames(cv model.ContentVariable) []string {
+	result := []string{cv.Name}
+	for _, sub := range cv.SubContentVariables {
+		result = append(result, contentVariableNames(sub)...)
+	}
+	return result
+}
+
 func contentVariableToCertList(cv model.ContentVariable) []ImportTypeCriteria {
 	result := []ImportTypeCriteria{{
-		FunctionId: cv.FunctionId,
-		AspectId:   cv.AspectId,
+		FunctionId:       cv.FunctionId,
+		AspectId:         cv.AspectId,
+		CharacteristicId: cv.CharacteristicId,
 	}}
 	for _, sub := range cv.SubContentVariables {
 		result = append(result, contentVariableToCertList(sub)...)
@@ -78,18 +103,74 @@ func init() {
 		panic(err)
 	}
 
+	tagsKey, err = getBsonFieldName(model.ImportType{}, "Tags")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for import type tags", attributes.ErrorKey, err)
+		panic(err)
+	}
+	categoryKey, err = getBsonFieldName(model.ImportType{}, "Category")
+	if err != nil {
+		log.Logger.Error("unable to get bson field name for import type category", attributes.ErrorKey, err)
+		panic(err)
+	}
+
+	for field, path := range model.SortFields {
+		sortKeys[field], err = getBsonFieldPath(model.ImportType{}, path)
+		if err != nil {
+			log.Logger.Error("unable to get bson key for import type sort field", "field", field, attributes.ErrorKey, err)
+			panic(err)
+		}
+	}
+
 	CreateCollections = append(CreateCollections, func(db *Mongo) error {
 		collection := db.client.Database(db.config.MongoTable).Collection(db.config.MongoImportTypeCollection)
 		err = db.ensureIndex(collection, "importTypeIdindex", idKey, true, true)
 		if err != nil {
 			return err
 		}
-		return nil
+		err = db.ensureIndex(collection, "importTypeTagsIndex", tagsKey, true, false)
+		if err != nil {
+			return err
+		}
+		err = db.ensureIndex(collection, "importTypeCategoryIndex", categoryKey, true, false)
+		if err != nil {
+			return err
+		}
+		return db.ensureImportTypeTextIndex(collection)
 	})
 }
 
+// text index fields with their weights; matches in the name are most relevant
+var textIndexWeights = bson.D{
+	{Key: "name", Value: 10},
+	{Key: "description", Value: 5},
+	{Key: "output_names", Value: 3},
+	{Key: "configs.name", Value: 3},
+	{Key: "configs.description", Value: 1},
+}
+
+const textScoreKey = "score"
+
+func (this *Mongo) ensureImportTypeTextIndex(collection *mongo.Collection) error {
+	ctx, _ := getTimeoutContext()
+	keys := bson.D{}
+	for _, field := range textIndexWeights {
+		keys = append(keys, bson.E{Key: field.Key, Value: "text"})
+	}
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    keys,
+		Options: options.Index().SetName("importTypeTextIndex").SetWeights(textIndexWeights).SetDefaultLanguage("none"),
+	})
+	return err
+}
+
 func (this *Mongo) migrateImportTypeCriteria() error {
-	c, err := this.importTypeCollection().Find(context.Background(), bson.M{"criteria": bson.M{"$exists": false}})
+	c, err := this.importTypeCollection().Find(context.Background(), bson.M{"$or": []bson.M{
+		{"criteria": bson.M{"$exists": false}},
+		{"output_names": bson.M{"$exists": false}},
+		{"criteria.characteristic_id": bson.M{"$exists": false}},
+		{etagKey: bson.M{"$exists": false}},
+	}})
 	if err != nil {
 		return err
 	}
@@ -103,6 +184,10 @@ func (this *Mongo) migrateImportTypeCriteria() error {
 		if err != nil {
 			return err
 		}
+		err = importTypeToRead(&element)
+		if err != nil {
+			return err
+		}
 		err = this.SetImportType(context.Background(), element)
 		if err != nil {
 			return err
@@ -124,46 +209,103 @@ func (this *Mongo) GetImportType(ctx context.Context, id string) (importType mod
 		}
 		return
 	}
-	err = result.Decode(&importType)
-	for idx, config := range importType.Configs {
-		err = configToRead(&config)
-		if err != nil {
-			return importType, true, err
-		}
-		importType.Configs[idx] = config
+	doc := ImportTypeDocument{}
+	err = result.Decode(&doc)
+	if err != nil {
+		return importType, true, err
 	}
+	importType, err = documentToImportType(doc)
 	return importType, true, err
 }
 
 func (this *Mongo) ListImportTypes(ctx context.Context, listOptions model.ImportTypeListOptions) (result []model.ImportType, total int64, err error) {
-	opt := options.Find()
-	if listOptions.Limit > 0 {
-		opt.SetLimit(listOptions.Limit)
-	}
-	if listOptions.Offset > 0 {
-		opt.SetSkip(listOptions.Offset)
-	}
-
 	if listOptions.SortBy == "" {
 		listOptions.SortBy = "name.asc"
 	}
 
-	sortby := listOptions.SortBy
-	sortby = strings.TrimSuffix(sortby, ".asc")
-	sortby = strings.TrimSuffix(sortby, ".desc")
+	field := model.SortField(listOptions.SortBy)
 
 	direction := int32(1)
 	if strings.HasSuffix(listOptions.SortBy, ".desc") {
 		direction = int32(-1)
 	}
-	opt.SetSort(bson.D{{sortby, direction}})
 
-	filter := bson.M{}
-	if listOptions.Ids != nil {
-		filter[idKey] = bson.M{"$in": listOptions.Ids}
+	filter, textSearch := importTypeListFilter(listOptions)
+	sortby, ok := sortKeys[field]
+	if field == model.SortByRelevance {
+		if !textSearch {
+			return result, total, errors.New("sort by relevance requires a text search")
+		}
+		sortby = textScoreKey
+		direction = -1
+	} else if !ok {
+		return result, total, errors.New("unknown sort field " + field)
+	}
+
+	if listOptions.Ids == nil {
+		return this.listImportTypes(ctx, filter, sortby, direction, listOptions.Limit, listOptions.Offset, listOptions)
+	}
+
+	// large id sets (e.g. all import types accessible to a user) are split into multiple queries, to keep each query small.
+	// every chunk query returns its first offset+limit elements; the merged and sorted results are then paginated.
+	chunks := chunkIds(listOptions.Ids, maxIdsPerQuery)
+	if len(chunks) == 0 {
+		return []model.ImportType{}, 0, nil
+	}
+	if len(chunks) == 1 {
+		filter[idKey] = bson.M{"$in": chunks[0]}
+		return this.listImportTypes(ctx, filter, sortby, direction, listOptions.Limit, listOptions.Offset, listOptions)
 	}
+	offset := listOptions.Offset
+	if listOptions.After != nil {
+		offset = 0
+	}
+	chunkLimit := int64(0)
+	if listOptions.Limit > 0 {
+		chunkLimit = offset + listOptions.Limit
+	}
+	type sortable struct {
+		importType model.ImportType
+		value      bson.RawValue
+	}
+	merged := []sortable{}
+	total = 0
+	for _, chunk := range chunks {
+		filter[idKey] = bson.M{"$in": chunk}
+		docs, chunkTotal, err := this.findImportTypeDocuments(ctx, filter, sortby, direction, chunkLimit, 0, listOptions)
+		if err != nil {
+			return result, total, err
+		}
+		total += chunkTotal
+		for _, doc := range docs {
+			merged = append(merged, sortable{importType: doc.importType, value: doc.raw.Lookup(strings.Split(sortby, ".")...)})
+		}
+	}
+	slices.SortStableFunc(merged, func(a, b sortable) int {
+		cmp := compareRawValues(a.value, b.value)
+		if cmp == 0 && sortby != idKey {
+			cmp = strings.Compare(a.importType.Id, b.importType.Id)
+		}
+		return cmp * int(direction)
+	})
+	result = []model.ImportType{}
+	for i := offset; i < int64(len(merged)) && (listOptions.Limit <= 0 || i < offset+listOptions.Limit); i++ {
+		result = append(result, merged[i].importType)
+	}
+	if listOptions.WithoutTotal {
+		total = -1
+	}
+	return result, total, nil
+}
+
+// importTypeListFilter translates the search, criteria, tags and categories of the list options to a filter; ids are handled by the caller
+func importTypeListFilter(listOptions model.ImportTypeListOptions) (filter bson.M, textSearch bool) {
+	filter = bson.M{}
 	search := strings.TrimSpace(listOptions.Search)
-	if search != "" {
+	textSearch = search != "" && listOptions.SearchMode != model.SearchModePrefix
+	if textSearch {
+		filter["$text"] = bson.M{"$search": search}
+	} else if search != "" {
 		escapedSearch := regexp.QuoteMeta(search)
 		filter[nameKey] = bson.M{"$regex": escapedSearch, "$options": "i"}
 	}
@@ -182,57 +324,271 @@ func (this *Mongo) ListImportTypes(ctx context.Context, listOptions model.Import
 		}
 		filter["$and"] = and
 	}
+	if len(listOptions.Tags) > 0 {
+		filter[tagsKey] = bson.M{"$all": listOptions.Tags}
+	}
+	if len(listOptions.Categories) > 0 {
+		filter[categoryKey] = bson.M{"$in": listOptions.Categories}
+	}
+	return filter, textSearch
+}
+
+// maxIdsPerQuery limits the size of id filters in a single query; variable to allow tests with small chunks
+var maxIdsPerQuery = 10000
+
+type importTypeDocumentResult struct {
+	importType model.ImportType
+	raw        bson.Raw
+}
 
-	cursor, err := this.importTypeCollection().Find(ctx, filter, opt)
+func (this *Mongo) listImportTypes(ctx context.Context, filter bson.M, sortby string, direction int32, limit int64, offset int64, listOptions model.ImportTypeListOptions) (result []model.ImportType, total int64, err error) {
+	docs, total, err := this.findImportTypeDocuments(ctx, filter, sortby, direction, limit, offset, listOptions)
 	if err != nil {
 		return result, total, err
 	}
-	err = cursor.All(ctx, &result)
+	result = []model.ImportType{}
+	for _, doc := range docs {
+		result = append(result, doc.importType)
+	}
+	return result, total, nil
+}
+
+// findImportTypeDocuments applies the cursor of the list options to filter and counts the total without it
+func (this *Mongo) findImportTypeDocuments(ctx context.Context, filter bson.M, sortby string, direction int32, limit int64, offset int64, listOptions model.ImportTypeListOptions) (result []importTypeDocumentResult, total int64, err error) {
+	// the id as second sort key ensures a stable order, which is needed for cursors
+	sort := bson.D{{Key: sortby, Value: direction}}
+	opt := options.Find()
+	if sortby == textScoreKey {
+		textScore := bson.M{"$meta": "textScore"}
+		sort = bson.D{{Key: textScoreKey, Value: textScore}}
+		opt.SetProjection(bson.M{textScoreKey: textScore})
+	}
+	if sortby != idKey {
+		sort = append(sort, bson.E{Key: idKey, Value: direction})
+	}
+	opt.SetSort(sort)
+	if limit > 0 {
+		opt.SetLimit(limit)
+	}
+	if offset > 0 && listOptions.After == nil {
+		opt.SetSkip(offset)
+	}
+
+	// the total refers to all matching import types, so the cursor position is only part of the find filter
+	findFilter := filter
+	if listOptions.After != nil {
+		findFilter = bson.M{"$and": []bson.M{filter, cursorFilter(sortby, direction, *listOptions.After)}}
+	}
+
+	cursor, err := this.importTypeCollection().Find(ctx, findFilter, opt)
 	if err != nil {
 		return result, total, err
 	}
-	if result == nil {
-		result = []model.ImportType{}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		raw := make(bson.Raw, len(cursor.Current))
+		copy(raw, cursor.Current)
+		doc := ImportTypeDocument{}
+		err = bson.Unmarshal(raw, &doc)
+		if err != nil {
+			return result, total, err
+		}
+		importType, err := documentToImportType(doc)
+		if err != nil {
+			return result, total, err
+		}
+		if !listOptions.WithEtag {
+			importType.Etag = ""
+		}
+		result = append(result, importTypeDocumentResult{importType: importType, raw: raw})
 	}
-	total, err = this.importTypeCollection().CountDocuments(ctx, filter)
+	err = cursor.Err()
 	if err != nil {
 		return result, total, err
 	}
+	if listOptions.WithoutTotal {
+		return result, -1, nil
+	}
+	total, err = this.importTypeCollection().CountDocuments(ctx, filter)
 	return result, total, err
 }
 
-func (this *Mongo) SetImportType(ctx context.Context, importType model.ImportType) error {
-	oldConfigs := []model.ImportConfig{}
-	for idx, config := range importType.Configs {
-		oldConfigs = append(oldConfigs, model.ImportConfig{
-			Name:               config.Name,
-			Description:        config.Description,
-			Type:               config.Type,
-			DefaultValue:       config.DefaultValue,
-			DefaultValueString: config.DefaultValueString,
-		})
-		err := configToWrite(&config)
-		if err != nil {
-			return err
+// chunkIds removes duplicates and splits the ids into chunks of at most size elements
+func chunkIds(ids []string, size int) (result [][]string) {
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	for start := 0; start < len(unique); start += size {
+		result = append(result, unique[start:min(start+size, len(unique))])
+	}
+	return result
+}
+
+func rawNumber(value bson.RawValue) float64 {
+	switch value.Type {
+	case bson.TypeInt32:
+		return float64(value.Int32())
+	case bson.TypeInt64:
+		return float64(value.Int64())
+	default:
+		return value.Double()
+	}
+}
+
+// compareRawValues compares values of the supported sort fields like mongodb: missing < numbers < strings < booleans
+func compareRawValues(a bson.RawValue, b bson.RawValue) int {
+	rank := func(value bson.RawValue) int {
+		switch value.Type {
+		case bson.TypeInt32, bson.TypeInt64, bson.TypeDouble:
+			return 1
+		case bson.TypeString:
+			return 2
+		case bson.TypeBoolean:
+			return 3
+		default:
+			return 0
 		}
-		importType.Configs[idx] = config
 	}
-	withCriteria := importTypeWithCriteria(importType)
-	_, err := this.importTypeCollection().ReplaceOne(ctx, bson.M{idKey: importType.Id}, withCriteria, options.Replace().SetUpsert(true))
+	if rankA, rankB := rank(a), rank(b); rankA != rankB {
+		return rankA - rankB
+	}
+	switch rank(a) {
+	case 1:
+		numberA, numberB := rawNumber(a), rawNumber(b)
+		if numberA < numberB {
+			return -1
+		}
+		if numberA > numberB {
+			return 1
+		}
+		return 0
+	case 2:
+		return strings.Compare(a.StringValue(), b.StringValue())
+	case 3:
+		if a.Boolean() == b.Boolean() {
+			return 0
+		}
+		if !a.Boolean() {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// cursorFilter matches the import types following the cursor position in the sort order
+func cursorFilter(sortby string, direction int32, cursor model.ImportTypeCursor) bson.M {
+	operator := "$gt"
+	if direction < 0 {
+		operator = "$lt"
+	}
+	if sortby == idKey {
+		return bson.M{idKey: bson.M{operator: cursor.Id}}
+	}
+	return bson.M{"$or": []bson.M{
+		{sortby: bson.M{operator: cursor.Value}},
+		{sortby: cursor.Value, idKey: bson.M{operator: cursor.Id}},
+	}}
+}
+
+func (this *Mongo) SetImportType(ctx context.Context, importType model.ImportType) error {
+	doc, err := importTypeToDocument(importType)
 	if err != nil {
 		return err
 	}
-	for i := range importType.Configs {
-		importType.Configs[i] = oldConfigs[i]
-	}
+	_, err = this.importTypeCollection().ReplaceOne(ctx, bson.M{idKey: importType.Id}, doc, options.Replace().SetUpsert(true))
 	return err
 }
 
+// SetImportTypeIfMatch replaces the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Mongo) SetImportTypeIfMatch(ctx context.Context, importType model.ImportType, etag string) error {
+	doc, err := importTypeToDocument(importType)
+	if err != nil {
+		return err
+	}
+	result, err := this.importTypeCollection().ReplaceOne(ctx, bson.M{idKey: importType.Id, etagKey: etag}, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return model.ErrPreconditionFailed
+	}
+	return nil
+}
+
 func (this *Mongo) RemoveImportType(ctx context.Context, id string) error {
 	_, err := this.importTypeCollection().DeleteOne(ctx, bson.M{idKey: id})
 	return err
 }
 
+// RemoveImportTypeIfMatch removes the stored import type only if its etag matches; returns model.ErrPreconditionFailed otherwise
+func (this *Mongo) RemoveImportTypeIfMatch(ctx context.Context, id string, etag string) error {
+	result, err := this.importTypeCollection().DeleteOne(ctx, bson.M{idKey: id, etagKey: etag})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return model.ErrPreconditionFailed
+	}
+	return nil
+}
+
+func importTypeToDocument(importType model.ImportType) (doc ImportTypeDocument, err error) {
+	doc.Etag, err = model.ImportTypeEtag(importType)
+	if err != nil {
+		return doc, err
+	}
+	importType, err = importTypeToWrite(importType)
+	if err != nil {
+		return doc, err
+	}
+	importType.Etag = ""
+	doc.ImportTypeWithCriteria = importTypeWithCriteria(importType)
+	return doc, nil
+}
+
+func documentToImportType(doc ImportTypeDocument) (importType model.ImportType, err error) {
+	importType = doc.ImportType
+	err = importTypeToRead(&importType)
+	if err != nil {
+		return importType, err
+	}
+	importType.Etag = doc.Etag
+	return importType, nil
+}
+
+// importTypeToWrite returns a copy of importType with configs in their storage representation; importType itself is not modified
+func importTypeToWrite(importType model.ImportType) (model.ImportType, error) {
+	configs := make([]model.ImportConfig, len(importType.Configs))
+	for idx, config := range importType.Configs {
+		err := configToWrite(&config)
+		if err != nil {
+			return importType, err
+		}
+		configs[idx] = config
+	}
+	if importType.Configs != nil {
+		importType.Configs = configs
+	}
+	return importType, nil
+}
+
+func importTypeToRead(importType *model.ImportType) error {
+	for idx, config := range importType.Configs {
+		err := configToRead(&config)
+		if err != nil {
+			return err
+		}
+		importType.Configs[idx] = config
+	}
+	return nil
+}
+
 func configToWrite(config *model.ImportConfig) error {
 	if config == nil {
 		return errors.New("nil config")
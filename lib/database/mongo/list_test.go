@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/docker"
+)
+
+func TestListWithIdChunks(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../../../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, ip, err := docker.MongoDB(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.MongoUrl = "mongodb://" + ip + ":27017"
+
+	db, err := New(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	ids := []string{}
+	for i := 0; i < 50; i++ {
+		importType := model.ImportType{Id: "it-" + strconv.Itoa(i), Name: "name-" + strconv.Itoa(i%7), Cost: uint64(i % 4)}
+		err = db.SetImportType(ctx, importType)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if i%3 != 0 {
+			ids = append(ids, importType.Id)
+		}
+	}
+
+	listIds := func(options model.ImportTypeListOptions) (result []string, total int64) {
+		list, total, err := db.ListImportTypes(ctx, options)
+		if err != nil {
+			t.Error(err)
+			return nil, 0
+		}
+		for _, element := range list {
+			result = append(result, element.Id)
+		}
+		return result, total
+	}
+
+	original := maxIdsPerQuery
+	defer func() { maxIdsPerQuery = original }()
+
+	for _, options := range []model.ImportTypeListOptions{
+		{Ids: ids, Limit: 10, SortBy: "name.asc"},
+		{Ids: ids, Limit: 10, Offset: 10, SortBy: "name.desc"},
+		{Ids: ids, Limit: 5, Offset: 30, SortBy: "cost.asc"},
+		{Ids: ids, SortBy: "id.desc"},
+		{Ids: ids, Limit: 10, SortBy: "name.asc", After: &model.ImportTypeCursor{SortBy: "name.asc", Value: "name-3", Id: "it-10"}},
+		{Ids: ids, Limit: 10, SortBy: "cost.desc", After: &model.ImportTypeCursor{SortBy: "cost.desc", Value: 2, Id: "it-22"}},
+		{Ids: append(ids, ids...), Limit: 10, SortBy: "name.asc"},
+		{Ids: []string{}, Limit: 10},
+	} {
+		maxIdsPerQuery = original
+		expected, expectedTotal := listIds(options)
+		maxIdsPerQuery = 4
+		actual, actualTotal := listIds(options)
+		if !reflect.DeepEqual(actual, expected) || actualTotal != expectedTotal {
+			t.Errorf("%#v\n%v %v\n%v %v", options, expectedTotal, expected, actualTotal, actual)
+		}
+		if options.Ids != nil && len(options.Ids) > 0 && expectedTotal != int64(len(ids)) {
+			t.Errorf("%#v %v", options, expectedTotal)
+		}
+	}
+}
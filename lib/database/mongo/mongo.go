@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,7 +42,8 @@ type Mongo struct {
 var CreateCollections = []func(db *Mongo) error{}
 
 func New(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (*Mongo, error) {
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(conf.MongoUrl))
+	// untyped values like config default values and enums are decoded as maps instead of primitive.D, to keep their json representation
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(conf.MongoUrl).SetBSONOptions(&options.BSONOptions{DefaultDocumentM: true}))
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +79,7 @@ func (this *Mongo) ensureIndex(collection *mongo.Collection, indexname string, i
 		direction = 1
 	}
 	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys:    bson.D{{indexKey, direction}},
+		Keys:    bson.D{{Key: indexKey, Value: direction}},
 		Options: options.Index().SetName(indexname).SetUnique(unique),
 	})
 	return err
@@ -100,6 +102,26 @@ func (this *Mongo) ensureCompoundIndex(collection *mongo.Collection, indexname s
 	return err
 }
 
+// Transaction executes f in a mongodb transaction, which requires a replica set or sharded cluster (config.MongoReplSet)
+// nested transactions are part of the outer transaction; database calls of f must not be executed concurrently.
+func (this *Mongo) Transaction(ctx context.Context, f func(ctx context.Context) error) error {
+	if !this.config.MongoReplSet {
+		return errors.New("transactions need a mongodb replica set (mongo_repl_set)")
+	}
+	if mongo.SessionFromContext(ctx) != nil {
+		return f(ctx)
+	}
+	session, err := this.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.Background())
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, f(sessionCtx)
+	})
+	return err
+}
+
 func (this *Mongo) Disconnect() {
 	err := this.client.Disconnect(context.Background())
 	if err != nil {
@@ -119,6 +141,25 @@ func getBsonFieldName(obj interface{}, fieldName string) (bsonName string, err e
 	return tags.Name, err
 }
 
+// getBsonFieldPath returns the bson key of a nested struct field (e.g. []string{"Output", "Name"}), separated by '.'
+func getBsonFieldPath(obj interface{}, fieldPath []string) (bsonPath string, err error) {
+	keys := []string{}
+	t := reflect.TypeOf(obj)
+	for _, fieldName := range fieldPath {
+		field, found := t.FieldByName(fieldName)
+		if !found {
+			return "", errors.New("field '" + fieldName + "' not found")
+		}
+		key, err := getBsonFieldName(reflect.New(t).Elem().Interface(), fieldName)
+		if err != nil {
+			return "", err
+		}
+		keys = append(keys, key)
+		t = field.Type
+	}
+	return strings.Join(keys, "."), nil
+}
+
 func getTimeoutContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), 10*time.Second)
 }
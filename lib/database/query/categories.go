@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// SortCategories sorts categories by name and id
+func SortCategories(categories []model.ImportTypeCategory) {
+	slices.SortFunc(categories, func(a, b model.ImportTypeCategory) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// Facets counts the import types matching the list options per function, aspect, characteristic, owner and cost bucket
+func Facets(importTypes []model.ImportType, listOptions model.ImportTypeListOptions) model.ImportTypeFacets {
+	filter := NewFilter(listOptions)
+	functions := map[string]int64{}
+	aspects := map[string]int64{}
+	characteristics := map[string]int64{}
+	owners := map[string]int64{}
+	costs := map[uint64]int64{}
+	for _, importType := range importTypes {
+		if !filter.Match(importType) {
+			continue
+		}
+		// every import type is counted once per value
+		seenFunctions, seenAspects, seenCharacteristics := map[string]bool{}, map[string]bool{}, map[string]bool{}
+		for _, criterion := range Criteria(importType.Output) {
+			countOnce(functions, seenFunctions, criterion.FunctionId)
+			countOnce(aspects, seenAspects, criterion.AspectId)
+			countOnce(characteristics, seenCharacteristics, criterion.CharacteristicId)
+		}
+		if importType.Owner != "" {
+			owners[importType.Owner]++
+		}
+		costs[costBucket(importType.Cost)]++
+	}
+	result := model.ImportTypeFacets{
+		Functions:       sortedFacetCounts(functions),
+		Aspects:         sortedFacetCounts(aspects),
+		Characteristics: sortedFacetCounts(characteristics),
+		Owners:          sortedFacetCounts(owners),
+		Costs:           []model.CostBucketCount{},
+	}
+	for i, lower := range model.CostBuckets {
+		bucket := model.CostBucketCount{Min: lower, Count: costs[lower]}
+		if i+1 < len(model.CostBuckets) {
+			upper := model.CostBuckets[i+1]
+			bucket.Max = &upper
+		}
+		result.Costs = append(result.Costs, bucket)
+	}
+	return result
+}
+
+func countOnce(counts map[string]int64, seen map[string]bool, value string) {
+	if value == "" || seen[value] {
+		return
+	}
+	seen[value] = true
+	counts[value]++
+}
+
+// costBucket returns the lower bound of the bucket containing cost; costs outside of all buckets are counted in the last one
+func costBucket(cost uint64) uint64 {
+	last := model.CostBuckets[len(model.CostBuckets)-1]
+	for i, lower := range model.CostBuckets[:len(model.CostBuckets)-1] {
+		if cost >= lower && cost < model.CostBuckets[i+1] {
+			return lower
+		}
+	}
+	return last
+}
+
+func sortedFacetCounts(counts map[string]int64) []model.FacetCount {
+	result := []model.FacetCount{}
+	for value, count := range counts {
+		result = append(result, model.FacetCount{Value: value, Count: count})
+	}
+	slices.SortFunc(result, func(a, b model.FacetCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Value, b.Value)
+	})
+	return result
+}
+
+// Tags counts the import types matching the list options per tag, sorted by count (descending) and tag
+func Tags(importTypes []model.ImportType, listOptions model.ImportTypeListOptions) []model.ImportTypeTagCount {
+	filter := NewFilter(listOptions)
+	counts := map[string]int64{}
+	for _, importType := range importTypes {
+		if !filter.Match(importType) {
+			continue
+		}
+		for _, tag := range importType.Tags {
+			counts[tag]++
+		}
+	}
+	result := []model.ImportTypeTagCount{}
+	for tag, count := range counts {
+		result = append(result, model.ImportTypeTagCount{Tag: tag, Count: count})
+	}
+	slices.SortFunc(result, func(a, b model.ImportTypeTagCount) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return result
+}
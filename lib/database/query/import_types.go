@@ -0,0 +1,343 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package query evaluates list options of the database interface on import types held in memory.
+// it reproduces the semantics of the mongodb implementation and is shared by the backends without a query engine.
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// Criterion is the function, aspect and characteristic of a single output variable
+type Criterion struct {
+	FunctionId       string `json:"function_id"`
+	AspectId         string `json:"aspect_id"`
+	CharacteristicId string `json:"characteristic_id"`
+}
+
+// Criteria flattens the output variable and all sub variables, the root included
+func Criteria(cv model.ContentVariable) []Criterion {
+	result := []Criterion{{
+		FunctionId:       cv.FunctionId,
+		AspectId:         cv.AspectId,
+		CharacteristicId: cv.CharacteristicId,
+	}}
+	for _, sub := range cv.SubContentVariables {
+		result = append(result, Criteria(sub)...)
+	}
+	return result
+}
+
+// MatchesCriteria checks if a single criterion fulfills the filter criteria, like an $elemMatch on the stored criteria list
+func MatchesCriteria(criterion Criterion, filter model.ImportTypeFilterCriteria) bool {
+	if filter.FunctionId != "" && criterion.FunctionId != filter.FunctionId {
+		return false
+	}
+	if len(filter.AspectIds) > 0 && !slices.Contains(filter.AspectIds, criterion.AspectId) {
+		return false
+	}
+	return true
+}
+
+// Filter matches import types against the search, criteria, tags, categories and ids of list options
+type Filter struct {
+	ids        map[string]bool
+	name       *regexp.Regexp
+	text       *TextSearch
+	criteria   []model.ImportTypeFilterCriteria
+	tags       []string
+	categories []string
+}
+
+func NewFilter(listOptions model.ImportTypeListOptions) Filter {
+	filter := Filter{
+		criteria:   listOptions.Criteria,
+		tags:       listOptions.Tags,
+		categories: listOptions.Categories,
+	}
+	if listOptions.Ids != nil {
+		filter.ids = map[string]bool{}
+		for _, id := range listOptions.Ids {
+			filter.ids[id] = true
+		}
+	}
+	search := strings.TrimSpace(listOptions.Search)
+	if search != "" && listOptions.SearchMode != model.SearchModePrefix {
+		filter.text = NewTextSearch(search)
+	} else if search != "" {
+		filter.name = regexp.MustCompile("(?i)" + regexp.QuoteMeta(search))
+	}
+	return filter
+}
+
+// TextSearch returns the text search of the filter or nil, if the options contain no text search
+func (this Filter) TextSearch() *TextSearch {
+	return this.text
+}
+
+func (this Filter) Match(importType model.ImportType) bool {
+	if this.ids != nil && !this.ids[importType.Id] {
+		return false
+	}
+	if this.name != nil && !this.name.MatchString(importType.Name) {
+		return false
+	}
+	if this.text != nil && !this.text.Match(importType) {
+		return false
+	}
+	if len(this.criteria) > 0 {
+		criteria := Criteria(importType.Output)
+		for _, filter := range this.criteria {
+			if !slices.ContainsFunc(criteria, func(criterion Criterion) bool {
+				return MatchesCriteria(criterion, filter)
+			}) {
+				return false
+			}
+		}
+	}
+	for _, tag := range this.tags {
+		if !slices.Contains(importType.Tags, tag) {
+			return false
+		}
+	}
+	if len(this.categories) > 0 && !slices.Contains(this.categories, importType.Category) {
+		return false
+	}
+	return true
+}
+
+// Sort is the parsed SortBy of list options
+type Sort struct {
+	Field      string //json field name (nested fields separated by '.') or model.SortByRelevance
+	Descending bool
+}
+
+// ParseSort parses sortBy (default name.asc); the field has to be one of model.SortFields or model.SortByRelevance.
+// sorting by relevance requires a text search and is always descending
+func ParseSort(sortBy string, textSearch bool) (result Sort, err error) {
+	if sortBy == "" {
+		sortBy = "name.asc"
+	}
+	result = Sort{Field: model.SortField(sortBy), Descending: strings.HasSuffix(sortBy, ".desc")}
+	if result.Field == model.SortByRelevance {
+		if !textSearch {
+			return result, errors.New("sort by relevance requires a text search")
+		}
+		result.Descending = true
+		return result, nil
+	}
+	if _, ok := model.SortFields[result.Field]; !ok {
+		return result, errors.New("unknown sort field " + result.Field)
+	}
+	return result, nil
+}
+
+// List filters, sorts and paginates import types like the mongodb implementation.
+// the Etag of each import type has to be set; it is removed from the result unless listOptions.WithEtag is set.
+func List(importTypes []model.ImportType, listOptions model.ImportTypeListOptions) (result []model.ImportType, total int64, err error) {
+	filter := NewFilter(listOptions)
+	sort, err := ParseSort(listOptions.SortBy, filter.TextSearch() != nil)
+	if err != nil {
+		return result, total, err
+	}
+	if listOptions.Ids != nil && len(listOptions.Ids) == 0 {
+		return []model.ImportType{}, 0, nil
+	}
+	matches := []model.ImportType{}
+	for _, importType := range importTypes {
+		if filter.Match(importType) {
+			matches = append(matches, importType)
+		}
+	}
+	SortImportTypes(matches, sort, filter.TextSearch())
+	return Page(matches, sort, listOptions), Total(len(matches), listOptions), nil
+}
+
+// Total returns the number of matching import types, or -1 if listOptions.WithoutTotal is set
+func Total(matches int, listOptions model.ImportTypeListOptions) int64 {
+	if listOptions.WithoutTotal {
+		return -1
+	}
+	return int64(matches)
+}
+
+func (this Sort) direction() int {
+	if this.Descending {
+		return -1
+	}
+	return 1
+}
+
+type sortable struct {
+	importType model.ImportType
+	value      any
+}
+
+// SortImportTypes sorts by the sort field and uses the id as second sort key, in the same direction.
+// the text search is only used to sort by relevance.
+func SortImportTypes(importTypes []model.ImportType, sort Sort, textSearch *TextSearch) {
+	elements := make([]sortable, len(importTypes))
+	for i, importType := range importTypes {
+		elements[i] = sortable{importType: importType}
+		if sort.Field == model.SortByRelevance && textSearch != nil {
+			elements[i].value = textSearch.Score(importType)
+		} else {
+			elements[i].value = SortValue(importType, sort.Field)
+		}
+	}
+	// the id as second sort key ensures a stable order, which is needed for cursors
+	slices.SortStableFunc(elements, func(a, b sortable) int {
+		cmp := CompareValues(a.value, b.value)
+		if cmp == 0 && sort.Field != "id" {
+			cmp = strings.Compare(a.importType.Id, b.importType.Id)
+		}
+		return cmp * sort.direction()
+	})
+	for i, element := range elements {
+		importTypes[i] = element.importType
+	}
+}
+
+// Page applies the cursor (or the offset) and the limit of the list options to import types sorted by sort.
+// etags are removed unless listOptions.WithEtag is set.
+func Page(sorted []model.ImportType, sort Sort, listOptions model.ImportTypeListOptions) (result []model.ImportType) {
+	offset := listOptions.Offset
+	if listOptions.After != nil {
+		offset = 0
+		sorted = slices.DeleteFunc(slices.Clone(sorted), func(importType model.ImportType) bool {
+			return !FollowsCursor(importType, sort, *listOptions.After)
+		})
+	}
+	result = Paginate(sorted, listOptions.Limit, offset)
+	if !listOptions.WithEtag {
+		for i := range result {
+			result[i].Etag = ""
+		}
+	}
+	return result
+}
+
+// FollowsCursor checks if the import type is behind the cursor position in the sort order.
+// like mongodb range queries, values of a different type than the cursor value never follow the cursor.
+func FollowsCursor(importType model.ImportType, sort Sort, cursor model.ImportTypeCursor) bool {
+	idCmp := strings.Compare(importType.Id, cursor.Id) * sort.direction()
+	if sort.Field == "id" {
+		return idCmp > 0
+	}
+	value := SortValue(importType, sort.Field)
+	cursorValue := normalizeValue(cursor.Value)
+	if valueRank(value) != valueRank(cursorValue) {
+		return false
+	}
+	cmp := CompareValues(value, cursorValue) * sort.direction()
+	if cmp != 0 {
+		return cmp > 0
+	}
+	return idCmp > 0
+}
+
+// SortValue returns the value of the json field of the import type (nested fields separated by '.'), nil if it does not exist
+func SortValue(importType model.ImportType, field string) any {
+	b, err := json.Marshal(importType)
+	if err != nil {
+		return nil
+	}
+	var value any
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return nil
+	}
+	for _, key := range strings.Split(field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return value
+}
+
+// valueRank orders the types of sort values like mongodb: missing < numbers < strings < booleans
+func valueRank(value any) int {
+	switch value.(type) {
+	case float64:
+		return 1
+	case string:
+		return 2
+	case bool:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// CompareValues compares sort values as returned by SortValue like mongodb compares the stored values
+func CompareValues(a any, b any) int {
+	a, b = normalizeValue(a), normalizeValue(b)
+	if rankA, rankB := valueRank(a), valueRank(b); rankA != rankB {
+		return rankA - rankB
+	}
+	switch valueA := a.(type) {
+	case float64:
+		valueB := b.(float64)
+		if valueA < valueB {
+			return -1
+		}
+		if valueA > valueB {
+			return 1
+		}
+	case string:
+		return strings.Compare(valueA, b.(string))
+	case bool:
+		if valueA == b.(bool) {
+			return 0
+		}
+		if !valueA {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// Paginate returns a copy of the elements of list selected by limit (ignored if <= 0) and offset
+func Paginate[T any](list []T, limit int64, offset int64) []T {
+	offset = min(max(offset, 0), int64(len(list)))
+	end := int64(len(list))
+	if limit > 0 {
+		end = min(end, offset+limit)
+	}
+	return append([]T{}, list[offset:end]...)
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"slices"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// NormalizeTime truncates times to milliseconds in UTC, like mongodb stores them
+func NormalizeTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Millisecond)
+}
+
+// NormalizeImportType returns a copy of importType with the times normalized by NormalizeTime; importType itself is not modified
+func NormalizeImportType(importType model.ImportType) model.ImportType {
+	if importType.Releases != nil {
+		importType.Releases = slices.Clone(importType.Releases)
+		for i, release := range importType.Releases {
+			importType.Releases[i].PublishedAt = NormalizeTime(release.PublishedAt)
+		}
+	}
+	return importType
+}
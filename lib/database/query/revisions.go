@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// ListRevisions sorts the revisions of an import type, newest first, and paginates them
+func ListRevisions(revisions []model.ImportTypeRevision, listOptions model.ImportTypeRevisionListOptions) (result []model.ImportTypeRevision, total int64) {
+	revisions = slices.Clone(revisions)
+	slices.SortFunc(revisions, func(a, b model.ImportTypeRevision) int {
+		return cmp.Compare(b.Revision, a.Revision)
+	})
+	return Paginate(revisions, listOptions.Limit, listOptions.Offset), int64(len(revisions))
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// TextWeights are the weights of the text indexed fields; matches in the name are most relevant
+var TextWeights = []struct {
+	Field  string
+	Weight float64
+	Values func(importType model.ImportType) []string
+}{
+	{Field: "name", Weight: 10, Values: func(importType model.ImportType) []string {
+		return []string{importType.Name}
+	}},
+	{Field: "description", Weight: 5, Values: func(importType model.ImportType) []string {
+		return []string{importType.Description}
+	}},
+	{Field: "output_names", Weight: 3, Values: func(importType model.ImportType) []string {
+		return outputNames(importType.Output)
+	}},
+	{Field: "configs.name", Weight: 3, Values: func(importType model.ImportType) []string {
+		result := []string{}
+		for _, config := range importType.Configs {
+			result = append(result, config.Name)
+		}
+		return result
+	}},
+	{Field: "configs.description", Weight: 1, Values: func(importType model.ImportType) []string {
+		result := []string{}
+		for _, config := range importType.Configs {
+			result = append(result, config.Description)
+		}
+		return result
+	}},
+}
+
+func outputNames(cv model.ContentVariable) []string {
+	result := []string{cv.Name}
+	for _, sub := range cv.SubContentVariables {
+		result = append(result, outputNames(sub)...)
+	}
+	return result
+}
+
+// TextSearch emulates the mongodb text index of import types with the language "none":
+// terms are matched case-insensitive and without stemming, "-term" excludes documents containing the term,
+// "\"some phrase\"" requires the phrase and "-\"some phrase\"" excludes documents containing it.
+type TextSearch struct {
+	terms          []string
+	negated        []string
+	phrases        []string
+	negatedPhrases []string
+}
+
+func NewTextSearch(search string) *TextSearch {
+	result := &TextSearch{}
+	negatePhrase := false
+	for i, part := range strings.Split(search, "\"") {
+		if i%2 == 1 {
+			phrase := strings.ToLower(strings.TrimSpace(part))
+			if phrase != "" && negatePhrase {
+				result.negatedPhrases = append(result.negatedPhrases, phrase)
+			} else if phrase != "" {
+				result.phrases = append(result.phrases, phrase)
+				result.terms = append(result.terms, Tokenize(phrase)...)
+			}
+			continue
+		}
+		words := strings.Fields(part)
+		// a '-' directly in front of a phrase negates it
+		negatePhrase = len(words) > 0 && words[len(words)-1] == "-" && strings.HasSuffix(part, "-")
+		for _, word := range words {
+			if strings.HasPrefix(word, "-") {
+				result.negated = append(result.negated, Tokenize(word)...)
+			} else {
+				result.terms = append(result.terms, Tokenize(word)...)
+			}
+		}
+	}
+	return result
+}
+
+// Tokenize splits text into lower case terms; whitespace and punctuation other than '_' separate terms
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return r != '_' && (unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r))
+	})
+}
+
+// Match checks if the import type contains a term and all phrases of the search, but no negated term
+func (this *TextSearch) Match(importType model.ImportType) bool {
+	terms := map[string]bool{}
+	texts := []string{}
+	for _, field := range TextWeights {
+		for _, value := range field.Values(importType) {
+			texts = append(texts, strings.ToLower(value))
+			for _, term := range Tokenize(value) {
+				terms[term] = true
+			}
+		}
+	}
+	for _, term := range this.negated {
+		if terms[term] {
+			return false
+		}
+	}
+	for _, phrase := range this.negatedPhrases {
+		if containsPhrase(texts, phrase) {
+			return false
+		}
+	}
+	for _, phrase := range this.phrases {
+		if !containsPhrase(texts, phrase) {
+			return false
+		}
+	}
+	for _, term := range this.terms {
+		if terms[term] {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPhrase(texts []string, phrase string) bool {
+	for _, text := range texts {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// Score computes the relevance of a matching import type like the mongodb text score:
+// per field value, every term occurrence adds less than the previous one, scaled by the share of the term in the value and the field weight.
+// values consisting only of the term get a small boost.
+func (this *TextSearch) Score(importType model.ImportType) (score float64) {
+	scores := map[string]float64{}
+	for _, field := range TextWeights {
+		for _, value := range field.Values(importType) {
+			scoreText(value, field.Weight, scores)
+		}
+	}
+	seen := map[string]bool{}
+	for _, term := range this.terms {
+		if !seen[term] {
+			seen[term] = true
+			score += scores[term]
+		}
+	}
+	return score
+}
+
+func scoreText(text string, weight float64, scores map[string]float64) {
+	type termStats struct {
+		count int
+		freq  float64
+		exp   float64
+	}
+	tokens := Tokenize(text)
+	stats := map[string]*termStats{}
+	for _, token := range tokens {
+		data, ok := stats[token]
+		if !ok {
+			data = &termStats{exp: 1}
+			stats[token] = data
+		} else {
+			data.exp *= 2
+		}
+		data.count++
+		data.freq += 1 / data.exp
+	}
+	for term, data := range stats {
+		coefficient := 0.5*float64(data.count)/float64(len(tokens)) + 0.5
+		adjustment := 1.0
+		if strings.EqualFold(text, term) {
+			adjustment += 0.1
+		}
+		scores[term] += weight * data.freq * coefficient * adjustment
+	}
+}
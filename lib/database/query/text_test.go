@@ -0,0 +1,59 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestTokenize(t *testing.T) {
+	actual := Tokenize("Weather-Station: temp_value, 42°C (outdoor)")
+	expected := []string{"weather", "station", "temp_value", "42", "c", "outdoor"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Error(actual, expected)
+	}
+}
+
+func TestTextSearch(t *testing.T) {
+	search := NewTextSearch(`weather -archive "data station" -"ignored"`)
+	if !reflect.DeepEqual(search.terms, []string{"weather", "data", "station"}) {
+		t.Error(search.terms)
+	}
+	if !reflect.DeepEqual(search.negated, []string{"archive"}) {
+		t.Error(search.negated)
+	}
+	if !reflect.DeepEqual(search.phrases, []string{"data station"}) {
+		t.Error(search.phrases)
+	}
+	if !reflect.DeepEqual(search.negatedPhrases, []string{"ignored"}) {
+		t.Error(search.negatedPhrases)
+	}
+
+	name := model.ImportType{Name: "Weather"}
+	description := model.ImportType{Description: "weather of the day"}
+	repeated := model.ImportType{Description: "weather weather of the day"}
+	search = NewTextSearch("weather")
+	if !(search.Score(name) > search.Score(repeated) && search.Score(repeated) > search.Score(description)) {
+		t.Error(search.Score(name), search.Score(repeated), search.Score(description))
+	}
+	if search.Score(model.ImportType{Name: "other"}) != 0 {
+		t.Error("unexpected score")
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+// ListTrash filters the trash entries, sorts them by deletion date (newest first) and import type id and paginates them
+func ListTrash(entries []model.TrashedImportType, listOptions model.TrashListOptions) (result []model.TrashedImportType, total int64) {
+	matches := []model.TrashedImportType{}
+	for _, entry := range entries {
+		if !listOptions.DeletedBefore.IsZero() && !entry.DeletedAt.Before(listOptions.DeletedBefore) {
+			continue
+		}
+		if listOptions.Administrator != nil && !isTrashAdministrator(entry, *listOptions.Administrator) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	slices.SortFunc(matches, func(a, b model.TrashedImportType) int {
+		if c := b.DeletedAt.Compare(a.DeletedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ImportType.Id, b.ImportType.Id)
+	})
+	return Paginate(matches, listOptions.Limit, listOptions.Offset), int64(len(matches))
+}
+
+func isTrashAdministrator(entry model.TrashedImportType, administrator model.TrashAdministrator) bool {
+	if entry.Permissions.UserPermissions[administrator.UserId].Administrate {
+		return true
+	}
+	for _, group := range administrator.Groups {
+		if entry.Permissions.GroupPermissions[group].Administrate {
+			return true
+		}
+	}
+	for _, role := range administrator.Roles {
+		if entry.Permissions.RolePermissions[role].Administrate {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,7 @@ package lib
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
 	"github.com/SENERGY-Platform/import-repository/lib/api"
@@ -28,6 +29,7 @@ import (
 	"github.com/SENERGY-Platform/import-repository/lib/log"
 	"github.com/SENERGY-Platform/import-repository/lib/source/consumer"
 	"github.com/SENERGY-Platform/import-repository/lib/source/consumer/listener"
+	"github.com/SENERGY-Platform/import-repository/lib/source/producer"
 	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
 )
 
@@ -36,13 +38,22 @@ func Start(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (err err
 }
 
 func StartWithPermv2Client(conf config.Config, ctx context.Context, wg *sync.WaitGroup, permV2Client permV2.Client) (err error) {
+	p, err := producer.New(conf, ctx, wg)
+	if err != nil {
+		log.Logger.Error("unable to create producer", attributes.ErrorKey, err)
+		return err
+	}
+	return StartWithDependencies(conf, ctx, wg, permV2Client, p)
+}
+
+func StartWithDependencies(conf config.Config, ctx context.Context, wg *sync.WaitGroup, permV2Client permV2.Client, producer controller.Producer) (err error) {
 	db, err := database.New(conf, ctx, wg)
 	if err != nil {
 		log.Logger.Error("unable to connect to database", attributes.ErrorKey, err)
 		return err
 	}
 
-	ctrl, err := controller.New(conf, db, permV2Client)
+	ctrl, err := controller.New(conf, db, permV2Client, producer)
 	if err != nil {
 		log.Logger.Error("unable to start control", attributes.ErrorKey, err)
 		return err
@@ -54,8 +65,39 @@ func StartWithPermv2Client(conf config.Config, ctx context.Context, wg *sync.Wai
 		return err
 	}
 
+	if conf.RepublishStartup {
+		err = ctrl.RepublishImportTypes()
+		if err != nil {
+			log.Logger.Error("unable to republish import types", attributes.ErrorKey, err)
+			return err
+		}
+	}
+
+	if conf.TrashRetention != "" {
+		retention, err := time.ParseDuration(conf.TrashRetention)
+		if err != nil {
+			log.Logger.Error("unable to parse trash_retention", attributes.ErrorKey, err)
+			return err
+		}
+		interval := time.Hour
+		if conf.TrashPurgeInterval != "" {
+			interval, err = time.ParseDuration(conf.TrashPurgeInterval)
+			if err != nil {
+				log.Logger.Error("unable to parse trash_purge_interval", attributes.ErrorKey, err)
+				return err
+			}
+		}
+		ctrl.StartTrashPurger(ctx, wg, retention, interval)
+	}
+
+	errorPolicy, err := consumer.ErrorPolicyFromConfig(conf, ctx, wg)
+	if err != nil {
+		log.Logger.Error("unable to create consumer error policy", attributes.ErrorKey, err)
+		return err
+	}
+
 	_, err = consumer.NewConsumer(ctx, wg, conf.KafkaBootstrap, []string{conf.UsersTopic}, conf.GroupId, consumer.Earliest,
-		listener.UsersListenerFactory(ctrl), consumer.HandleError, conf.Debug)
+		listener.UsersListenerFactory(ctrl), errorPolicy, consumer.HandleError, conf.Debug)
 	if err != nil {
 		log.Logger.Warn("unable to start source, retrying periodically...", attributes.ErrorKey, err)
 	}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AvroRecord is an avro record schema; structures and lists with fixed elements are mapped to records
+type AvroRecord struct {
+	Type   string      `json:"type"` //always "record"
+	Name   string      `json:"name"`
+	Doc    string      `json:"doc,omitempty"`
+	Fields []AvroField `json:"fields"`
+	AvroMetadata
+}
+
+// AvroArray is an avro array schema; lists with a single sub content variable named "*" are mapped to arrays
+type AvroArray struct {
+	Type  string      `json:"type"` //always "array"
+	Items interface{} `json:"items"`
+}
+
+// AvroPrimitive is a primitive avro type with metadata; only used for outputs which are not structures
+type AvroPrimitive struct {
+	Type string `json:"type"`
+	AvroMetadata
+}
+
+type AvroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"` //primitive type name, AvroRecord or AvroArray
+	AvroMetadata
+}
+
+// AvroMetadata preserves content variable information as custom avro attributes
+type AvroMetadata struct {
+	SourceName       string `json:"source_name,omitempty"` //content variable name, if it is not a valid avro name
+	CharacteristicId string `json:"characteristic_id,omitempty"`
+	FunctionId       string `json:"function_id,omitempty"`
+	AspectId         string `json:"aspect_id,omitempty"`
+	UseAsTag         bool   `json:"use_as_tag,omitempty"`
+}
+
+// AvroSchema generates an avro schema of the output of an import type.
+// names are derived deterministically: invalid characters are replaced by '_', duplicates get a numeric suffix,
+// the root record is named after the import type and nested records after their path.
+func AvroSchema(importType ImportType) interface{} {
+	if importType.Output.Type != Structure && importType.Output.Type != List {
+		return AvroPrimitive{Type: avroPrimitiveType(importType.Output.Type), AvroMetadata: avroMetadata(importType.Output, "")}
+	}
+	result := avroType(importType.Output, schemaName(importType.Name), uniqueNames{})
+	if record, ok := result.(AvroRecord); ok {
+		record.Doc = importType.Description
+		return record
+	}
+	return result
+}
+
+func avroType(variable ContentVariable, recordName string, recordNames uniqueNames) interface{} {
+	switch variable.Type {
+	case Structure:
+		return avroRecord(variable.SubContentVariables, recordName, recordNames)
+	case List:
+		if IsListWildcard(variable) {
+			return AvroArray{Type: "array", Items: avroType(variable.SubContentVariables[0], recordName+"_item", recordNames)}
+		}
+		return avroRecord(variable.SubContentVariables, recordName, recordNames)
+	}
+	return avroPrimitiveType(variable.Type)
+}
+
+func avroRecord(fields []ContentVariable, name string, recordNames uniqueNames) AvroRecord {
+	result := AvroRecord{Type: "record", Name: recordNames.get(name), Fields: []AvroField{}}
+	fieldNames := uniqueNames{}
+	for _, sub := range fields {
+		fieldName := fieldNames.get(schemaName(sub.Name))
+		result.Fields = append(result.Fields, AvroField{
+			Name:         fieldName,
+			Type:         avroType(sub, result.Name+"_"+fieldName, recordNames),
+			AvroMetadata: avroMetadata(sub, fieldName),
+		})
+	}
+	return result
+}
+
+func avroMetadata(variable ContentVariable, name string) AvroMetadata {
+	result := AvroMetadata{
+		CharacteristicId: variable.CharacteristicId,
+		FunctionId:       variable.FunctionId,
+		AspectId:         variable.AspectId,
+		UseAsTag:         variable.UseAsTag,
+	}
+	if name != "" && name != variable.Name {
+		result.SourceName = variable.Name
+	}
+	return result
+}
+
+func avroPrimitiveType(t Type) string {
+	switch t {
+	case String:
+		return "string"
+	case Integer:
+		return "long"
+	case Float:
+		return "double"
+	case Boolean:
+		return "boolean"
+	}
+	return "null"
+}
+
+// schemaName replaces characters which are not allowed in avro and protobuf names ([A-Za-z_][A-Za-z0-9_]*) by '_'
+func schemaName(name string) string {
+	result := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "_" + result
+	}
+	return result
+}
+
+// uniqueNames appends a numeric suffix to names which have already been used
+type uniqueNames map[string]bool
+
+func (this uniqueNames) get(name string) string {
+	result := name
+	for i := 2; this[result]; i++ {
+		result = name + "_" + strconv.Itoa(i)
+	}
+	this[result] = true
+	return result
+}
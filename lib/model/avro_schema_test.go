@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var schemaTestImportType = ImportType{
+	Id:   "urn:infai:ses:import-type:1",
+	Name: "weather-import",
+	Output: ContentVariable{Name: "output", Type: Structure, SubContentVariables: []ContentVariable{
+		{Name: "station", Type: String, UseAsTag: true},
+		{Name: "temperature", Type: Float, CharacteristicId: "urn:infai:ses:characteristic:celsius"},
+		{Name: "wind-speed", Type: Float},
+		{Name: "wind_speed", Type: Integer},
+		{Name: "history", Type: List, SubContentVariables: []ContentVariable{{Name: "*", Type: List, SubContentVariables: []ContentVariable{{Name: "*", Type: Float}}}}},
+		{Name: "position", Type: List, SubContentVariables: []ContentVariable{{Name: "0", Type: Float}, {Name: "1", Type: Float}}},
+	}},
+}
+
+func TestAvroSchema(t *testing.T) {
+	b, err := json.Marshal(AvroSchema(schemaTestImportType))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := `{"type":"record","name":"weather_import","fields":[` +
+		`{"name":"station","type":"string","use_as_tag":true},` +
+		`{"name":"temperature","type":"double","characteristic_id":"urn:infai:ses:characteristic:celsius"},` +
+		`{"name":"wind_speed","type":"double","source_name":"wind-speed"},` +
+		`{"name":"wind_speed_2","type":"long","source_name":"wind_speed"},` +
+		`{"name":"history","type":{"type":"array","items":{"type":"array","items":"double"}}},` +
+		`{"name":"position","type":{"type":"record","name":"weather_import_position","fields":[{"name":"_0","type":"double","source_name":"0"},{"name":"_1","type":"double","source_name":"1"}]}}]}`
+	if string(b) != expected {
+		t.Error(string(b))
+	}
+}
+
+func TestProtoSchema(t *testing.T) {
+	expected := `// output of import type urn:infai:ses:import-type:1
+syntax = "proto3";
+
+package urn_infai_ses_import_type_1;
+
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.FieldOptions {
+  string source_name = 50001;
+  string characteristic_id = 50002;
+  string function_id = 50003;
+  string aspect_id = 50004;
+  bool use_as_tag = 50005;
+}
+
+message weather_import {
+  string station = 1 [(use_as_tag) = true];
+  double temperature = 2 [(characteristic_id) = "urn:infai:ses:characteristic:celsius"];
+  double wind_speed = 3 [(source_name) = "wind-speed"];
+  int64 wind_speed_2 = 4 [(source_name) = "wind_speed"];
+  repeated weather_import_history_item history = 5;
+  weather_import_position position = 6;
+}
+
+message weather_import_history_item {
+  repeated double values = 1;
+}
+
+message weather_import_position {
+  double _0 = 1 [(source_name) = "0"];
+  double _1 = 2 [(source_name) = "1"];
+}
+`
+	if actual := ProtoSchema(schemaTestImportType); actual != expected {
+		t.Error(actual)
+	}
+	primitive := ProtoSchema(ImportType{Id: "2", Name: "count", Output: ContentVariable{Name: "value", Type: Integer}})
+	if !strings.Contains(primitive, "message count {\n  int64 value = 1;\n}\n") {
+		t.Error(primitive)
+	}
+}
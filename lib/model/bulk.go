@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+type BulkOperationType string
+
+const (
+	BulkCreate BulkOperationType = "create"
+	BulkUpdate BulkOperationType = "update"
+	BulkDelete BulkOperationType = "delete"
+)
+
+// ImportTypeBulkOperation is a single item of a bulk request
+// create and update expect ImportType (update uses ImportType.Id and ImportType.Etag), delete expects Id and optionally Etag
+type ImportTypeBulkOperation struct {
+	Operation  BulkOperationType `json:"operation"`
+	Id         string            `json:"id,omitempty"`
+	Etag       string            `json:"etag,omitempty"`
+	ImportType *ImportType       `json:"import_type,omitempty"`
+	Force      bool              `json:"force,omitempty"` //update: allow breaking changes without release version bump
+}
+
+// ImportTypeBulkResult reports the outcome of the ImportTypeBulkOperation with the same index
+type ImportTypeBulkResult struct {
+	Operation BulkOperationType   `json:"operation"`
+	Id        string              `json:"id"`
+	Code      int                 `json:"code"`
+	Error     string              `json:"error,omitempty"`
+	Findings  []ValidationFinding `json:"findings,omitempty"` //set if the import type is invalid
+	Changes   []ImportTypeChange  `json:"changes,omitempty"`  //set if an update is rejected because of breaking changes
+}
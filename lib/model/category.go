@@ -0,0 +1,31 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// ImportTypeCategory is an entry of the managed category list, which is maintained by admins.
+// ImportType.Category has to reference the id of an existing category.
+type ImportTypeCategory struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ImportTypeTagCount is the number of readable import types using a tag
+type ImportTypeTagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "strings"
+
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// ImportTypeChange is a classified difference between two versions of an import type.
+// Path is a json pointer into the new version, or into the old version for removed fields.
+type ImportTypeChange struct {
+	Path     string     `json:"path"`
+	Kind     ChangeKind `json:"kind"`
+	Breaking bool       `json:"breaking"`
+	Message  string     `json:"message"`
+}
+
+// OutputCompatibility is compatible if consumers of the current output are able to read messages of the proposed output
+type OutputCompatibility struct {
+	Compatible bool               `json:"compatible"`
+	Changes    []ImportTypeChange `json:"changes"`
+}
+
+// ImportTypeDiff lists the classified changes between two versions of an import type
+type ImportTypeDiff struct {
+	Breaking bool               `json:"breaking"`
+	Changes  []ImportTypeChange `json:"changes"`
+}
+
+// BreakingChangesError rejects an update with breaking changes, which is neither forced nor bumps the release version
+type BreakingChangesError struct {
+	Changes []ImportTypeChange `json:"changes"`
+}
+
+func (this *BreakingChangesError) Error() string {
+	messages := []string{}
+	for _, change := range this.Changes {
+		messages = append(messages, change.Path+": "+change.Message)
+	}
+	return "breaking changes: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+)
+
+// CursorSortFields are the sort fields which support cursor pagination
+var CursorSortFields = []string{"id", "name", "description", "image", "owner", "cost"}
+
+// SortFields maps the supported sort fields (json field names, nested fields separated by '.') to the path of the ImportType struct field.
+// every database resolves sort fields through this table, so that multi-word fields sort the same regardless of the storage key.
+var SortFields = map[string][]string{
+	"id":                       {"Id"},
+	"name":                     {"Name"},
+	"description":              {"Description"},
+	"image":                    {"Image"},
+	"default_restart":          {"DefaultRestart"},
+	"owner":                    {"Owner"},
+	"cost":                     {"Cost"},
+	"category":                 {"Category"},
+	"output.name":              {"Output", "Name"},
+	"output.type":              {"Output", "Type"},
+	"output.characteristic_id": {"Output", "CharacteristicId"},
+	"output.use_as_tag":        {"Output", "UseAsTag"},
+	"output.function_id":       {"Output", "FunctionId"},
+	"output.aspect_id":         {"Output", "AspectId"},
+}
+
+// ImportTypeCursor marks the position after the last import type of a page.
+// it is passed to clients as opaque string (see EncodeImportTypeCursor).
+type ImportTypeCursor struct {
+	SortBy string `json:"s"`
+	Value  any    `json:"v"`
+	Id     string `json:"i"`
+}
+
+func EncodeImportTypeCursor(cursor ImportTypeCursor) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func DecodeImportTypeCursor(cursor string) (result ImportTypeCursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return result, errors.New("invalid cursor")
+	}
+	err = json.Unmarshal(b, &result)
+	if err != nil || result.Id == "" {
+		return result, errors.New("invalid cursor")
+	}
+	return result, nil
+}
+
+// SortField returns the field name of a sort parameter like "name.asc"
+func SortField(sortBy string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(sortBy, ".asc"), ".desc")
+}
+
+// NextImportTypeCursor returns the cursor of the page following a page of ImportType or ImportTypeExtended elements.
+// the cursor is empty if the page is not full, because then no further elements exist.
+func NextImportTypeCursor[T ImportType | ImportTypeExtended](sortBy string, limit int64, page []T) (string, error) {
+	if limit <= 0 || int64(len(page)) < limit {
+		return "", nil
+	}
+	field := SortField(sortBy)
+	if !slices.Contains(CursorSortFields, field) {
+		return "", errors.New("sort field does not support cursors")
+	}
+	b, err := json.Marshal(page[len(page)-1])
+	if err != nil {
+		return "", err
+	}
+	values := map[string]any{}
+	err = json.Unmarshal(b, &values)
+	if err != nil {
+		return "", err
+	}
+	id, _ := values["id"].(string)
+	return EncodeImportTypeCursor(ImportTypeCursor{SortBy: sortBy, Value: values[field], Id: id})
+}
@@ -26,6 +26,8 @@ var ErrBadRequest = errors.New("bad request")
 var ErrInternalServerError = errors.New("internal server error")
 var ErrForbidden = fmt.Errorf("forbidden")
 var ErrNotFound = fmt.Errorf("not found")
+var ErrPreconditionFailed = errors.New("precondition failed")
+var ErrConflict = errors.New("conflict")
 
 func GetStatusCode(err error) int {
 	if err == nil {
@@ -43,6 +45,12 @@ func GetStatusCode(err error) int {
 	if errors.Is(err, ErrForbidden) {
 		return http.StatusForbidden
 	}
+	if errors.Is(err, ErrPreconditionFailed) {
+		return http.StatusPreconditionFailed
+	}
+	if errors.Is(err, ErrConflict) {
+		return http.StatusConflict
+	}
 	return http.StatusInternalServerError
 }
 
@@ -58,6 +66,10 @@ func GetError(code int) error {
 		return ErrNotFound
 	case http.StatusForbidden:
 		return ErrForbidden
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	case http.StatusConflict:
+		return ErrConflict
 	default:
 		return ErrInternalServerError
 	}
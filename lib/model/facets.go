@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+// ImportTypeFacets counts the import types matching a list query, grouped by the listed values.
+// Every import type is counted at most once per value; empty values are not counted.
+// Function, aspect, characteristic and owner counts are sorted by count (descending) and value.
+type ImportTypeFacets struct {
+	Functions       []FacetCount      `json:"functions"`
+	Aspects         []FacetCount      `json:"aspects"`
+	Characteristics []FacetCount      `json:"characteristics"`
+	Owners          []FacetCount      `json:"owners"`
+	Costs           []CostBucketCount `json:"costs"` //one entry per CostBuckets element, in the same order
+}
+
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+type CostBucketCount struct {
+	Min   uint64  `json:"min"`
+	Max   *uint64 `json:"max"` //exclusive; nil for the last bucket
+	Count int64   `json:"count"`
+}
+
+// CostBuckets are the lower bounds of the cost facet buckets; each bucket ends before the next bound, the last one is open
+var CostBuckets = []uint64{0, 1, 10, 100, 1000}
@@ -16,31 +16,82 @@
 
 package model
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	"github.com/SENERGY-Platform/models/go/models"
+)
+
 type ImportType struct {
-	Id             string          `json:"id"`
-	Name           string          `json:"name"`
-	Description    string          `json:"description"`
-	Image          string          `json:"image"`
-	DefaultRestart bool            `json:"default_restart"`
-	Configs        []ImportConfig  `json:"configs"`
-	Output         ContentVariable `json:"output"`
-	Owner          string          `json:"owner"`
-	Cost           uint64          `json:"cost"`
+	Id             string              `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	Image          string              `json:"image"` //set to the image of the latest stable release, if one exists
+	DefaultRestart bool                `json:"default_restart"`
+	Configs        []ImportConfig      `json:"configs"`
+	Output         ContentVariable     `json:"output"`
+	Owner          string              `json:"owner"`
+	Cost           uint64              `json:"cost"`
+	Tags           []string            `json:"tags,omitempty"`     //free-form classification; empty tags, tags with commas and duplicates are not allowed
+	Category       string              `json:"category,omitempty"` //id of a model.ImportTypeCategory
+	Releases       []ImportTypeRelease `json:"releases,omitempty"`
+	Etag           string              `json:"etag,omitempty" bson:"-"` //content hash of the stored import type; only set if requested (e.g. ImportTypeListOptions.WithEtag) and used as precondition on updates if not empty
 }
 
 type ImportTypeExtended struct {
-	Id                 string          `json:"id"`
-	Name               string          `json:"name"`
-	Description        string          `json:"description"`
-	Image              string          `json:"image"`
-	DefaultRestart     bool            `json:"default_restart"`
-	Configs            []ImportConfig  `json:"configs"`
-	ContentAspectIds   []string        `json:"content_aspect_ids"`
-	ContentFunctionIds []string        `json:"content_function_ids"`
-	Output             ContentVariable `json:"output"`
-	AspectFunctions    []string        `json:"aspect_functions"`
-	Owner              string          `json:"owner"`
-	Cost               uint64          `json:"cost"`
+	Id                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Description        string                `json:"description"`
+	Image              string                `json:"image"`
+	DefaultRestart     bool                  `json:"default_restart"`
+	Configs            []ImportConfig        `json:"configs"`
+	ContentAspectIds   []string              `json:"content_aspect_ids"`
+	ContentFunctionIds []string              `json:"content_function_ids"`
+	Output             ContentVariable       `json:"output"`
+	AspectFunctions    []string              `json:"aspect_functions"`
+	Owner              string                `json:"owner"`
+	Cost               uint64                `json:"cost"`
+	Tags               []string              `json:"tags,omitempty"`
+	Category           string                `json:"category,omitempty"`
+	Releases           []ImportTypeRelease   `json:"releases,omitempty"`
+	References         *ImportTypeReferences `json:"references,omitempty"` //only set if requested
+}
+
+// ImportTypeReferences contains the device-repository metadata of the aspects, functions and characteristics used by the output, by id
+// ids unknown to the device-repository are missing
+type ImportTypeReferences struct {
+	Aspects         map[string]models.AspectNode     `json:"aspects"`
+	Functions       map[string]models.Function       `json:"functions"`
+	Characteristics map[string]models.Characteristic `json:"characteristics"`
+}
+
+// ImportTypeEtag computes the content hash of an import type, ignoring the current Etag field.
+// the hash includes default values of secret configs; users who do not receive them have to use the etag of the stored version.
+func ImportTypeEtag(importType ImportType) (string, error) {
+	importType.Etag = ""
+	b, err := json.Marshal(importType)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:16]), nil
+}
+
+// WithoutSecretDefaults returns a copy of the import type without the default values of secret configs
+func WithoutSecretDefaults(importType ImportType) ImportType {
+	if !slices.ContainsFunc(importType.Configs, func(conf ImportConfig) bool { return conf.Secret }) {
+		return importType
+	}
+	importType.Configs = slices.Clone(importType.Configs)
+	for i, conf := range importType.Configs {
+		if conf.Secret {
+			importType.Configs[i].DefaultValue = nil
+		}
+	}
+	return importType
 }
 
 func ExtendImportType(importType ImportType) ImportTypeExtended {
@@ -54,6 +105,9 @@ func ExtendImportType(importType ImportType) ImportTypeExtended {
 		Output:         importType.Output,
 		Owner:          importType.Owner,
 		Cost:           importType.Cost,
+		Tags:           importType.Tags,
+		Category:       importType.Category,
+		Releases:       importType.Releases,
 	}
 	aspectFunctions := make(map[string]interface{})
 	aspects := make(map[string]interface{})
@@ -82,6 +136,9 @@ func ShrinkImportType(importType ImportTypeExtended) ImportType {
 		Output:         importType.Output,
 		Owner:          importType.Owner,
 		Cost:           importType.Cost,
+		Tags:           importType.Tags,
+		Category:       importType.Category,
+		Releases:       importType.Releases,
 	}
 }
 
@@ -101,22 +158,44 @@ func fillAspectFunctions(aspectFunctions map[string]interface{}, aspects map[str
 }
 
 type ImportConfig struct {
-	Name               string      `json:"name"`
-	Description        string      `json:"description"`
-	Type               Type        `json:"type"`
-	DefaultValue       interface{} `json:"default_value"`
-	DefaultValueString *string     `json:"-"`
+	Name               string        `json:"name"`
+	Description        string        `json:"description"`
+	Type               Type          `json:"type"`
+	DefaultValue       interface{}   `json:"default_value"` //never returned to users other than the owner, if Secret is set
+	DefaultValueString *string       `json:"-"`
+	Enum               []interface{} `json:"enum,omitempty"`    //allowed values; only for strings, integers and floats
+	Minimum            *float64      `json:"minimum,omitempty"` //inclusive; only for integers and floats
+	Maximum            *float64      `json:"maximum,omitempty"` //inclusive; only for integers and floats
+	Pattern            string        `json:"pattern,omitempty"` //regular expression (RE2 syntax) a string has to match
+	Required           bool          `json:"required,omitempty"`
+	Secret             bool          `json:"secret,omitempty"`
 }
 
 type ImportTypeListOptions struct {
-	Ids      []string //filter; ignores limit/offset if Ids != nil; ignored if Ids == nil; Ids == []string{} will return an empty list;
-	Search   string
-	Limit    int64                      //default 100, will be ignored if 'ids' is set (Ids != nil)
-	Offset   int64                      //default 0, will be ignored if 'ids' is set (Ids != nil)
-	SortBy   string                     //default name.asc
-	Criteria []ImportTypeFilterCriteria //filter; ignored if nil
+	Ids          []string //filter; ignored if Ids == nil; Ids == []string{} will return an empty list;
+	Search       string
+	SearchMode   SearchMode                 //default SearchModeText
+	Limit        int64                      //default 100
+	Offset       int64                      //default 0
+	SortBy       string                     //default name.asc; "relevance" orders text search results by score
+	Criteria     []ImportTypeFilterCriteria //filter; ignored if nil
+	Tags         []string                   //filter; only import types with all tags are listed
+	Categories   []string                   //filter; only import types with one of the categories are listed
+	WithEtag     bool                       //if true, the Etag field of each result is set
+	After        *ImportTypeCursor          //continues after the cursor position instead of using Offset; has to use the same SortBy
+	WithoutTotal bool                       //if true, the total is not counted and returned as -1
 }
 
+type SearchMode string
+
+const (
+	SearchModeText   SearchMode = "text"   //matches words of name, description, config names and descriptions and output variable names, weighted by field
+	SearchModePrefix SearchMode = "prefix" //case-insensitive match of the search term anywhere in the name
+)
+
+// SortByRelevance orders text search results by score, best match first
+const SortByRelevance = "relevance"
+
 type ImportTypeFilterCriteria struct {
 	FunctionId string   `json:"function_id"`
 	AspectIds  []string `json:"aspect_ids"`
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+const JsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JsonSchema is the subset of JSON Schema (draft 2020-12) used to describe import type configs and outputs.
+// characteristic, function and aspect ids are exposed as custom annotations (x-...), which are ignored by validators.
+type JsonSchema struct {
+	Schema               string                `json:"$schema,omitempty"`
+	Title                string                `json:"title,omitempty"`
+	Description          string                `json:"description,omitempty"`
+	Type                 string                `json:"type,omitempty"`
+	Properties           map[string]JsonSchema `json:"properties,omitempty"`
+	Required             []string              `json:"required,omitempty"`
+	AdditionalProperties *bool                 `json:"additionalProperties,omitempty"`
+	Items                *JsonSchema           `json:"items,omitempty"`
+	PrefixItems          []JsonSchema          `json:"prefixItems,omitempty"`
+	MinItems             *int                  `json:"minItems,omitempty"`
+	MaxItems             *int                  `json:"maxItems,omitempty"`
+	Enum                 []interface{}         `json:"enum,omitempty"`
+	Default              interface{}           `json:"default,omitempty"`
+	Minimum              *float64              `json:"minimum,omitempty"`
+	Maximum              *float64              `json:"maximum,omitempty"`
+	Pattern              string                `json:"pattern,omitempty"`
+	WriteOnly            bool                  `json:"writeOnly,omitempty"`
+	SchemaOrgType        Type                  `json:"x-schema-org-type,omitempty"`
+	CharacteristicId     string                `json:"x-characteristic-id,omitempty"`
+	FunctionId           string                `json:"x-function-id,omitempty"`
+	AspectId             string                `json:"x-aspect-id,omitempty"`
+	UseAsTag             bool                  `json:"x-use-as-tag,omitempty"`
+}
+
+// JsonSchemaType maps the schema.org type constants to JSON Schema types; unknown types are mapped to ""
+func JsonSchemaType(t Type) string {
+	switch t {
+	case String:
+		return "string"
+	case Integer:
+		return "integer"
+	case Float:
+		return "number"
+	case Boolean:
+		return "boolean"
+	case List:
+		return "array"
+	case Structure:
+		return "object"
+	}
+	return ""
+}
+
+// ConfigsJsonSchema describes the config values of an import type as a json object, keyed by config name.
+// configs are required if they are marked as required and have no default value. secret default values are never included.
+func ConfigsJsonSchema(importType ImportType) JsonSchema {
+	additionalProperties := false
+	result := JsonSchema{
+		Schema:               JsonSchemaDialect,
+		Title:                importType.Name,
+		Description:          importType.Description,
+		Type:                 "object",
+		Properties:           map[string]JsonSchema{},
+		Required:             []string{},
+		AdditionalProperties: &additionalProperties,
+	}
+	for _, conf := range importType.Configs {
+		property := JsonSchema{
+			Description:   conf.Description,
+			Type:          JsonSchemaType(conf.Type),
+			Enum:          conf.Enum,
+			Minimum:       conf.Minimum,
+			Maximum:       conf.Maximum,
+			Pattern:       conf.Pattern,
+			WriteOnly:     conf.Secret,
+			SchemaOrgType: conf.Type,
+		}
+		if !conf.Secret {
+			property.Default = conf.DefaultValue
+		}
+		result.Properties[conf.Name] = property
+		if conf.Required && conf.DefaultValue == nil {
+			result.Required = append(result.Required, conf.Name)
+		}
+	}
+	return result
+}
+
+// OutputJsonSchema describes the messages produced by an import type
+func OutputJsonSchema(importType ImportType) JsonSchema {
+	result := ContentVariableJsonSchema(importType.Output)
+	result.Schema = JsonSchemaDialect
+	if importType.Description != "" {
+		result.Description = importType.Description
+	}
+	return result
+}
+
+// ContentVariableJsonSchema describes a content variable and its sub content variables.
+// sub content variables of structures are required properties.
+// a list with a single sub content variable named "*" has items of this variable, other lists have a fixed length and prefixItems.
+func ContentVariableJsonSchema(variable ContentVariable) JsonSchema {
+	result := JsonSchema{
+		Title:            variable.Name,
+		Type:             JsonSchemaType(variable.Type),
+		SchemaOrgType:    variable.Type,
+		CharacteristicId: variable.CharacteristicId,
+		FunctionId:       variable.FunctionId,
+		AspectId:         variable.AspectId,
+		UseAsTag:         variable.UseAsTag,
+	}
+	switch variable.Type {
+	case Structure:
+		result.Properties = map[string]JsonSchema{}
+		result.Required = []string{}
+		for _, sub := range variable.SubContentVariables {
+			result.Properties[sub.Name] = ContentVariableJsonSchema(sub)
+			result.Required = append(result.Required, sub.Name)
+		}
+	case List:
+		if IsListWildcard(variable) {
+			items := ContentVariableJsonSchema(variable.SubContentVariables[0])
+			result.Items = &items
+		} else if len(variable.SubContentVariables) > 0 {
+			length := len(variable.SubContentVariables)
+			result.MinItems = &length
+			result.MaxItems = &length
+			for _, sub := range variable.SubContentVariables {
+				result.PrefixItems = append(result.PrefixItems, ContentVariableJsonSchema(sub))
+			}
+		}
+	}
+	return result
+}
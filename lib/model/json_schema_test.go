@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestConfigsJsonSchema(t *testing.T) {
+	maximum := float64(60)
+	schema := ConfigsJsonSchema(ImportType{Name: "test", Configs: []ImportConfig{
+		{Name: "interval", Type: Integer, DefaultValue: float64(10), Maximum: &maximum},
+		{Name: "unit", Type: String, Enum: []interface{}{"s", "m"}, Required: true},
+		{Name: "api_key", Type: String, DefaultValue: "secret", Secret: true, Required: true},
+	}})
+	if schema.Schema != JsonSchemaDialect || schema.Type != "object" || schema.AdditionalProperties == nil || *schema.AdditionalProperties {
+		t.Errorf("%#v", schema)
+	}
+	if !reflect.DeepEqual(schema.Required, []string{"unit"}) {
+		t.Error(schema.Required)
+	}
+	interval := schema.Properties["interval"]
+	if interval.Type != "integer" || interval.Default != float64(10) || interval.Maximum == nil || *interval.Maximum != 60 {
+		t.Errorf("%#v", interval)
+	}
+	if unit := schema.Properties["unit"]; unit.Type != "string" || len(unit.Enum) != 2 {
+		t.Errorf("%#v", unit)
+	}
+	if apiKey := schema.Properties["api_key"]; apiKey.Default != nil || !apiKey.WriteOnly {
+		t.Errorf("%#v", apiKey)
+	}
+}
+
+func TestOutputJsonSchema(t *testing.T) {
+	schema := OutputJsonSchema(ImportType{Output: ContentVariable{Name: "output", Type: Structure, SubContentVariables: []ContentVariable{
+		{Name: "value", Type: Float, CharacteristicId: "urn:infai:ses:characteristic:celsius", FunctionId: "urn:infai:ses:measuring-function:temperature", AspectId: "urn:infai:ses:aspect:air"},
+		{Name: "history", Type: List, SubContentVariables: []ContentVariable{{Name: "*", Type: Float}}},
+		{Name: "position", Type: List, SubContentVariables: []ContentVariable{{Name: "0", Type: Float}, {Name: "1", Type: Float}}},
+		{Name: "station", Type: String, UseAsTag: true},
+	}}})
+	b, err := json.Marshal(schema)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := `{"$schema":"https://json-schema.org/draft/2020-12/schema","title":"output","type":"object",` +
+		`"properties":{` +
+		`"history":{"title":"history","type":"array","items":{"title":"*","type":"number","x-schema-org-type":"https://schema.org/Float"},"x-schema-org-type":"https://schema.org/ItemList"},` +
+		`"position":{"title":"position","type":"array","prefixItems":[{"title":"0","type":"number","x-schema-org-type":"https://schema.org/Float"},{"title":"1","type":"number","x-schema-org-type":"https://schema.org/Float"}],"minItems":2,"maxItems":2,"x-schema-org-type":"https://schema.org/ItemList"},` +
+		`"station":{"title":"station","type":"string","x-schema-org-type":"https://schema.org/Text","x-use-as-tag":true},` +
+		`"value":{"title":"value","type":"number","x-schema-org-type":"https://schema.org/Float","x-characteristic-id":"urn:infai:ses:characteristic:celsius","x-function-id":"urn:infai:ses:measuring-function:temperature","x-aspect-id":"urn:infai:ses:aspect:air"}},` +
+		`"required":["value","history","position","station"],"x-schema-org-type":"https://schema.org/StructuredValue"}`
+	if string(b) != expected {
+		t.Error(string(b))
+	}
+}
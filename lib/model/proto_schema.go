@@ -0,0 +1,126 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ProtoSchema generates a proto3 definition of the output of an import type.
+// messages are named like the records of AvroSchema; field numbers follow the order of the sub content variables.
+// outputs which are not structures are wrapped in a message with a single field.
+// content variable metadata is preserved as custom field options.
+func ProtoSchema(importType ImportType) string {
+	generator := &protoGenerator{names: uniqueNames{}}
+	rootName := schemaName(importType.Name)
+	output := importType.Output
+	if output.Type == Structure || (output.Type == List && !IsListWildcard(output)) {
+		generator.message(rootName, output.SubContentVariables)
+	} else {
+		generator.message(rootName, []ContentVariable{output})
+	}
+	b := strings.Builder{}
+	b.WriteString("// output of import type " + importType.Id + "\n")
+	b.WriteString("syntax = \"proto3\";\n\n")
+	b.WriteString("package " + schemaName(importType.Id) + ";\n\n")
+	b.WriteString("import \"google/protobuf/descriptor.proto\";\n\n")
+	b.WriteString("extend google.protobuf.FieldOptions {\n")
+	b.WriteString("  string source_name = 50001;\n")
+	b.WriteString("  string characteristic_id = 50002;\n")
+	b.WriteString("  string function_id = 50003;\n")
+	b.WriteString("  string aspect_id = 50004;\n")
+	b.WriteString("  bool use_as_tag = 50005;\n")
+	b.WriteString("}\n")
+	for _, message := range generator.messages {
+		b.WriteString("\n" + message)
+	}
+	return b.String()
+}
+
+type protoGenerator struct {
+	names    uniqueNames
+	messages []string
+}
+
+// message adds a message and returns its name; nested messages are added after their parent
+func (this *protoGenerator) message(name string, fields []ContentVariable) string {
+	name = this.names.get(name)
+	index := len(this.messages)
+	this.messages = append(this.messages, "")
+	b := strings.Builder{}
+	b.WriteString("message " + name + " {\n")
+	fieldNames := uniqueNames{}
+	for i, field := range fields {
+		fieldName := fieldNames.get(schemaName(field.Name))
+		fmt.Fprintf(&b, "  %v %v = %v%v;\n", this.fieldType(field, name+"_"+fieldName), fieldName, i+1, protoOptions(field, fieldName))
+	}
+	b.WriteString("}\n")
+	this.messages[index] = b.String()
+	return name
+}
+
+func (this *protoGenerator) fieldType(variable ContentVariable, messageName string) string {
+	switch variable.Type {
+	case Structure:
+		return this.message(messageName, variable.SubContentVariables)
+	case List:
+		if !IsListWildcard(variable) {
+			return this.message(messageName, variable.SubContentVariables)
+		}
+		item := variable.SubContentVariables[0]
+		if item.Type == List && IsListWildcard(item) {
+			// repeated fields can not be nested directly
+			item.Name = "values"
+			return "repeated " + this.message(messageName+"_item", []ContentVariable{item})
+		}
+		return "repeated " + this.fieldType(item, messageName+"_item")
+	case String:
+		return "string"
+	case Integer:
+		return "int64"
+	case Float:
+		return "double"
+	case Boolean:
+		return "bool"
+	}
+	return "bytes"
+}
+
+func protoOptions(variable ContentVariable, fieldName string) string {
+	options := []string{}
+	if variable.Name != fieldName {
+		options = append(options, "(source_name) = "+strconv.Quote(variable.Name))
+	}
+	if variable.CharacteristicId != "" {
+		options = append(options, "(characteristic_id) = "+strconv.Quote(variable.CharacteristicId))
+	}
+	if variable.FunctionId != "" {
+		options = append(options, "(function_id) = "+strconv.Quote(variable.FunctionId))
+	}
+	if variable.AspectId != "" {
+		options = append(options, "(aspect_id) = "+strconv.Quote(variable.AspectId))
+	}
+	if variable.UseAsTag {
+		options = append(options, "(use_as_tag) = true")
+	}
+	if len(options) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(options, ", ") + "]"
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportTypeRelease is a published version of the import image.
+// ImportType.Releases is ordered by ascending Version; ImportType.Image is the image of the latest stable release.
+type ImportTypeRelease struct {
+	Version     string         `json:"version"` //semantic version 2.0.0 without "v" prefix, e.g. 1.2.0-beta.1
+	Image       string         `json:"image"`   //container image reference, e.g. ghcr.io/senergy-platform/import-weather:1.2.0
+	Changelog   string         `json:"changelog"`
+	Channel     ReleaseChannel `json:"channel"`
+	PublishedAt time.Time      `json:"published_at"`
+}
+
+type ReleaseChannel string
+
+const (
+	ReleaseChannelStable     ReleaseChannel = "stable"
+	ReleaseChannelBeta       ReleaseChannel = "beta"
+	ReleaseChannelDeprecated ReleaseChannel = "deprecated"
+)
+
+func (this ReleaseChannel) Valid() bool {
+	return this == ReleaseChannelStable || this == ReleaseChannelBeta || this == ReleaseChannelDeprecated
+}
+
+// ResolveReleaseImage sets the image of the import type to the image of the latest stable release; without stable release, the image is not changed
+func ResolveReleaseImage(importType ImportType) ImportType {
+	if release, found := LatestStableRelease(importType.Releases); found {
+		importType.Image = release.Image
+	}
+	return importType
+}
+
+// LatestStableRelease returns the stable release with the highest version; releases with invalid versions are ignored
+func LatestStableRelease(releases []ImportTypeRelease) (result ImportTypeRelease, found bool) {
+	var latest Semver
+	for _, release := range releases {
+		if release.Channel != ReleaseChannelStable {
+			continue
+		}
+		version, err := ParseSemver(release.Version)
+		if err != nil {
+			continue
+		}
+		if !found || CompareSemver(version, latest) > 0 {
+			result, latest, found = release, version, true
+		}
+	}
+	return result, found
+}
+
+// Semver is a parsed semantic version (https://semver.org/spec/v2.0.0.html)
+type Semver struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease []string //dot separated identifiers after "-"
+	Build      string   //ignored for comparisons
+}
+
+var semverRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+func ParseSemver(version string) (result Semver, err error) {
+	match := semverRegex.FindStringSubmatch(version)
+	if match == nil {
+		return result, errors.New("invalid semantic version " + strconv.Quote(version))
+	}
+	for i, target := range []*uint64{&result.Major, &result.Minor, &result.Patch} {
+		*target, err = strconv.ParseUint(match[i+1], 10, 64)
+		if err != nil {
+			return result, errors.New("invalid semantic version " + strconv.Quote(version))
+		}
+	}
+	if match[4] != "" {
+		result.PreRelease = strings.Split(match[4], ".")
+	}
+	result.Build = match[5]
+	return result, nil
+}
+
+// CompareSemver returns -1, 0 or 1 by semver precedence; pre-releases are lower than the release and build metadata is ignored
+func CompareSemver(a Semver, b Semver) int {
+	for _, pair := range [][2]uint64{{a.Major, b.Major}, {a.Minor, b.Minor}, {a.Patch, b.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	if len(a.PreRelease) == 0 || len(b.PreRelease) == 0 {
+		switch {
+		case len(a.PreRelease) == len(b.PreRelease):
+			return 0
+		case len(a.PreRelease) == 0:
+			return 1
+		default:
+			return -1
+		}
+	}
+	for i := 0; i < len(a.PreRelease) && i < len(b.PreRelease); i++ {
+		if cmp := comparePreReleaseIdentifier(a.PreRelease[i], b.PreRelease[i]); cmp != 0 {
+			return cmp
+		}
+	}
+	switch {
+	case len(a.PreRelease) < len(b.PreRelease):
+		return -1
+	case len(a.PreRelease) > len(b.PreRelease):
+		return 1
+	}
+	return 0
+}
+
+// numeric identifiers are compared numerically and have lower precedence than alphanumeric identifiers
+func comparePreReleaseIdentifier(a string, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		if aNum == bNum {
+			return 0
+		}
+		if aNum < bNum {
+			return -1
+		}
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "testing"
+
+func TestCompareSemver(t *testing.T) {
+	// ordered by precedence, see https://semver.org/spec/v2.0.0.html#spec-item-11
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.0", "1.10.0", "2.0.0"}
+	for i := range ordered {
+		for j := range ordered {
+			a, err := ParseSemver(ordered[i])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			b, err := ParseSemver(ordered[j])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			expected := 0
+			if i < j {
+				expected = -1
+			}
+			if i > j {
+				expected = 1
+			}
+			if actual := CompareSemver(a, b); actual != expected {
+				t.Error(ordered[i], ordered[j], actual, expected)
+			}
+		}
+	}
+	a, _ := ParseSemver("1.0.0+build.1")
+	b, _ := ParseSemver("1.0.0+build.2")
+	if CompareSemver(a, b) != 0 {
+		t.Error("build metadata should be ignored")
+	}
+}
+
+func TestParseSemver(t *testing.T) {
+	for _, invalid := range []string{"", "1", "1.0", "v1.0.0", "01.0.0", "1.0.0-", "1.0.0-01", "1.0.0+", "1.0.0 "} {
+		if _, err := ParseSemver(invalid); err == nil {
+			t.Error("expected error for", invalid)
+		}
+	}
+	version, err := ParseSemver("1.2.3-beta.1+exp.sha.5114f85")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if version.Major != 1 || version.Minor != 2 || version.Patch != 3 || len(version.PreRelease) != 2 || version.Build != "exp.sha.5114f85" {
+		t.Errorf("%#v", version)
+	}
+}
+
+func TestLatestStableRelease(t *testing.T) {
+	release, found := LatestStableRelease([]ImportTypeRelease{
+		{Version: "1.0.0", Image: "a", Channel: ReleaseChannelStable},
+		{Version: "1.1.0", Image: "b", Channel: ReleaseChannelStable},
+		{Version: "1.2.0", Image: "c", Channel: ReleaseChannelDeprecated},
+		{Version: "2.0.0-beta.1", Image: "d", Channel: ReleaseChannelBeta},
+	})
+	if !found || release.Image != "b" {
+		t.Error(found, release)
+	}
+	_, found = LatestStableRelease([]ImportTypeRelease{{Version: "1.0.0", Image: "a", Channel: ReleaseChannelBeta}})
+	if found {
+		t.Error("unexpected stable release")
+	}
+}
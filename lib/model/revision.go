@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "time"
+
+// ImportTypeRevision is an immutable snapshot of an import type, written on every create, update and restore
+type ImportTypeRevision struct {
+	ImportTypeId string     `json:"import_type_id"`
+	Revision     int64      `json:"revision"`
+	Author       string     `json:"author"`
+	Date         time.Time  `json:"date"`
+	ImportType   ImportType `json:"import_type"`
+}
+
+type ImportTypeRevisionListOptions struct {
+	Limit  int64 //default 100
+	Offset int64 //default 0
+}
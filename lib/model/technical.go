@@ -37,3 +37,8 @@ type ContentVariable struct {
 	FunctionId          string            `json:"function_id,omitempty"`
 	AspectId            string            `json:"aspect_id,omitempty"`
 }
+
+// IsListWildcard checks if the elements of a list are described by a single sub content variable named "*"; other lists have one element per sub content variable
+func IsListWildcard(variable ContentVariable) bool {
+	return len(variable.SubContentVariables) == 1 && variable.SubContentVariables[0].Name == "*"
+}
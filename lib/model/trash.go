@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+// TrashedImportType is a deleted import type, which can be restored until it is purged.
+// Permissions holds the permissions at the time of deletion and is used to restore them.
+type TrashedImportType struct {
+	ImportType  ImportType                      `json:"import_type"`
+	Permissions permV2Model.ResourcePermissions `json:"permissions"`
+	DeletedBy   string                          `json:"deleted_by"` //user who deleted the import type, or the removed user if it was deleted with its last administrator
+	DeletedAt   time.Time                       `json:"deleted_at"`
+}
+
+type TrashListOptions struct {
+	Limit         int64               //default 100
+	Offset        int64               //default 0
+	DeletedBefore time.Time           //if not zero, only entries deleted before are listed
+	Administrator *TrashAdministrator //if set, only entries the administrator was allowed to administrate are listed
+}
+
+// TrashAdministrator identifies a user by id, groups and roles to match the Permissions of TrashedImportType
+type TrashAdministrator struct {
+	UserId string
+	Groups []string
+	Roles  []string
+}
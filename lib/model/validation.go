@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import "strings"
+
+type ValidationFindingCode string
+
+const (
+	ValidationRequired             ValidationFindingCode = "required"
+	ValidationDuplicate            ValidationFindingCode = "duplicate"
+	ValidationInvalidType          ValidationFindingCode = "invalid_type"
+	ValidationInvalidDefaultValue  ValidationFindingCode = "invalid_default_value"
+	ValidationNotAllowed           ValidationFindingCode = "not_allowed"
+	ValidationUnknownReference     ValidationFindingCode = "unknown_reference"
+	ValidationInvalidFormat        ValidationFindingCode = "invalid_format"
+	ValidationInvalidOrder         ValidationFindingCode = "invalid_order"
+	ValidationInvalidRange         ValidationFindingCode = "invalid_range"
+	ValidationInvalidValue         ValidationFindingCode = "invalid_value"
+	ValidationReferenceCheckFailed ValidationFindingCode = "reference_check_failed" //the device-repository could not be asked
+)
+
+// ValidationFinding describes a single problem of an import type
+type ValidationFinding struct {
+	Path    string                `json:"path"` //json pointer (RFC 6901) to the invalid field, e.g. /output/sub_content_variables/2/characteristic_id
+	Code    ValidationFindingCode `json:"code"`
+	Message string                `json:"message"`
+}
+
+// ValidationResult is the result of a dry run validation
+type ValidationResult struct {
+	Valid    bool                `json:"valid"`
+	Findings []ValidationFinding `json:"findings"`
+}
+
+// ValidationError lists all findings of an invalid import type
+type ValidationError struct {
+	Findings []ValidationFinding `json:"findings"`
+}
+
+func (this *ValidationError) Error() string {
+	messages := []string{}
+	for _, finding := range this.Findings {
+		messages = append(messages, finding.Path+": "+finding.Message)
+	}
+	return "invalid import type: " + strings.Join(messages, "; ")
+}
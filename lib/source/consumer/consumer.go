@@ -30,8 +30,8 @@ import (
 // const Latest = sarama.OffsetNewest
 const Earliest = sarama.OffsetOldest
 
-func NewConsumer(ctx context.Context, wg *sync.WaitGroup, kafkaBootstrap string, topics []string, groupId string, offset int64, listener func(topic string, msg []byte, time time.Time) error, errorhandler func(err error, consumer *Consumer), debug bool) (consumer *Consumer, err error) {
-	consumer = &Consumer{ctx: ctx, wg: wg, kafkaBootstrap: kafkaBootstrap, topics: topics, listener: listener, errorhandler: errorhandler, offset: offset, ready: make(chan bool), groupId: groupId, debug: debug}
+func NewConsumer(ctx context.Context, wg *sync.WaitGroup, kafkaBootstrap string, topics []string, groupId string, offset int64, listener func(topic string, msg []byte, time time.Time) error, errorPolicy ErrorPolicy, errorhandler func(err error, consumer *Consumer), debug bool) (consumer *Consumer, err error) {
+	consumer = &Consumer{ctx: ctx, wg: wg, kafkaBootstrap: kafkaBootstrap, topics: topics, listener: listener, errorPolicy: errorPolicy, errorhandler: errorhandler, offset: offset, ready: make(chan bool), groupId: groupId, debug: debug}
 	err = consumer.start()
 	if err != nil {
 		go func(err2 error) {
@@ -56,6 +56,7 @@ type Consumer struct {
 	ctx            context.Context
 	wg             *sync.WaitGroup
 	listener       func(topic string, msg []byte, time time.Time) error
+	errorPolicy    ErrorPolicy
 	errorhandler   func(err error, consumer *Consumer)
 	mux            sync.Mutex
 	offset         int64
@@ -114,17 +115,27 @@ func (this *Consumer) Cleanup(sarama.ConsumerGroupSession) error {
 }
 
 // ConsumeClaim must start a consumer loop of ConsumerGroupClaim's Messages().
+// messages are processed until the consumer is stopped or the session ends (e.g. on a rebalance);
+// a message interrupted by either is not marked and will be consumed again by the next owner of the claim.
 func (this *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx, cancel := context.WithCancel(session.Context())
+	defer cancel()
+	stop := context.AfterFunc(this.ctx, cancel)
+	defer stop()
 	for message := range claim.Messages() {
 		select {
-		case <-this.ctx.Done():
-			log.Logger.Info("ignoring queued kafka messages for faster shutdown")
+		case <-ctx.Done():
+			log.Logger.Info("ignoring queued kafka messages for faster shutdown or rebalance")
 			return nil
 		default:
 			if this.debug {
 				log.Logger.Debug("kafka message", "topic", message.Topic, "timestamp", message.Timestamp, "value", string(message.Value))
 			}
-			err := this.listener(message.Topic, message.Value, message.Timestamp)
+			err := this.errorPolicy.Process(ctx, message, this.handleMessage)
+			if ctx.Err() != nil {
+				log.Logger.Info("stop processing kafka message for shutdown or rebalance", "topic", message.Topic, "offset", message.Offset)
+				return nil
+			}
 			if err != nil {
 				this.errorhandler(err, this)
 			}
@@ -135,6 +146,10 @@ func (this *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sa
 	return nil
 }
 
+func (this *Consumer) handleMessage(message *sarama.ConsumerMessage) error {
+	return this.listener(message.Topic, message.Value, message.Timestamp)
+}
+
 func HandleError(err error, _ *Consumer) {
 	log.Logger.Error("consumer handler returned error", attributes.ErrorKey, err)
 	panic("Failing hard in order to prevent committing of invalid offsets!")
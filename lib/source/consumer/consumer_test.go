@@ -0,0 +1,268 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+)
+
+func TestErrorPolicies(t *testing.T) {
+	log.InitForTest()
+	errTest := errors.New("test error")
+
+	t.Run("fail hard", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 1)
+		handled := []error{}
+		c := &Consumer{
+			ctx:          context.Background(),
+			listener:     failingListener(-1, errTest),
+			errorPolicy:  FailHard,
+			errorhandler: func(err error, _ *Consumer) { handled = append(handled, err); panic("fail hard") },
+		}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			_ = c.ConsumeClaim(session, claim)
+		}()
+		if len(session.marked) != 0 {
+			t.Error("offset marked after failure", session.marked)
+		}
+		if len(handled) != 1 || !errors.Is(handled[0], errTest) {
+			t.Error(handled)
+		}
+	})
+
+	t.Run("retry until success", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 1)
+		calls := 0
+		listener := failingListener(2, errTest)
+		c := &Consumer{
+			ctx: context.Background(),
+			listener: func(topic string, msg []byte, time time.Time) error {
+				calls++
+				return listener(topic, msg, time)
+			},
+			errorPolicy:  ErrorPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond},
+			errorhandler: func(err error, _ *Consumer) { t.Error("unexpected error handler call", err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		if calls != 3 {
+			t.Error(calls)
+		}
+		if !reflect.DeepEqual(session.marked, []int64{0}) {
+			t.Error(session.marked)
+		}
+	})
+
+	t.Run("dead letter", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 2)
+		store := &memoryDeadLetterStore{}
+		c := &Consumer{
+			ctx:          context.Background(),
+			listener:     failingListener(-1, errTest),
+			errorPolicy:  ErrorPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond, DeadLetter: store},
+			errorhandler: func(err error, _ *Consumer) { t.Error("unexpected error handler call", err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		if !reflect.DeepEqual(session.marked, []int64{0, 1}) {
+			t.Error(session.marked)
+		}
+		if len(store.letters) != 2 {
+			t.Error(store.letters)
+			return
+		}
+		letter := store.letters[1]
+		if letter.Topic != "users" || letter.Offset != 1 || letter.Error != errTest.Error() || letter.Attempts != 3 || string(letter.Value) != `{"command":"DELETE","id":"1"}` {
+			t.Errorf("%#v", letter)
+		}
+	})
+
+	t.Run("dead letter store failure", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 1)
+		handled := []error{}
+		c := &Consumer{
+			ctx:          context.Background(),
+			listener:     failingListener(-1, errTest),
+			errorPolicy:  ErrorPolicy{DeadLetter: &memoryDeadLetterStore{err: errors.New("store error")}},
+			errorhandler: func(err error, _ *Consumer) { handled = append(handled, err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		if len(handled) != 1 || !errors.Is(handled[0], errTest) {
+			t.Error(handled)
+		}
+	})
+
+	t.Run("shutdown during backoff", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		c := &Consumer{
+			ctx: ctx,
+			listener: func(string, []byte, time.Time) error {
+				cancel()
+				return errTest
+			},
+			errorPolicy:  ErrorPolicy{MaxRetries: 10, InitialBackoff: time.Hour},
+			errorhandler: func(err error, _ *Consumer) { t.Error("unexpected error handler call", err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		if len(session.marked) != 0 {
+			t.Error(session.marked)
+		}
+	})
+
+	t.Run("session end during backoff", func(t *testing.T) {
+		session, claim := newFakeClaim("users", 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		session.ctx = ctx
+		c := &Consumer{
+			ctx: context.Background(),
+			listener: func(string, []byte, time.Time) error {
+				cancel()
+				return errTest
+			},
+			errorPolicy:  ErrorPolicy{MaxRetries: 10, InitialBackoff: time.Hour},
+			errorhandler: func(err error, _ *Consumer) { t.Error("unexpected error handler call", err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		if len(session.marked) != 0 {
+			t.Error(session.marked)
+		}
+	})
+
+	t.Run("file dead letter store", func(t *testing.T) {
+		location := filepath.Join(t.TempDir(), "deadletters.jsonl")
+		store, err := NewFileDeadLetterStore(location)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		session, claim := newFakeClaim("users", 2)
+		c := &Consumer{
+			ctx:          context.Background(),
+			listener:     failingListener(-1, errTest),
+			errorPolicy:  ErrorPolicy{DeadLetter: store},
+			errorhandler: func(err error, _ *Consumer) { t.Error("unexpected error handler call", err) },
+		}
+		_ = c.ConsumeClaim(session, claim)
+		file, err := os.Open(location)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer file.Close()
+		offsets := []int64{}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			letter := DeadLetter{}
+			err = json.Unmarshal(scanner.Bytes(), &letter)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			offsets = append(offsets, letter.Offset)
+		}
+		if !reflect.DeepEqual(offsets, []int64{0, 1}) {
+			t.Error(offsets)
+		}
+	})
+}
+
+// failingListener returns err for the first n calls; n < 0 fails forever
+func failingListener(n int, err error) func(topic string, msg []byte, time time.Time) error {
+	calls := 0
+	return func(string, []byte, time.Time) error {
+		calls++
+		if n < 0 || calls <= n {
+			return err
+		}
+		return nil
+	}
+}
+
+type memoryDeadLetterStore struct {
+	mux     sync.Mutex
+	letters []DeadLetter
+	err     error
+}
+
+func (this *memoryDeadLetterStore) Store(letter DeadLetter) error {
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	if this.err != nil {
+		return this.err
+	}
+	this.letters = append(this.letters, letter)
+	return nil
+}
+
+// newFakeClaim returns a session and a claim with count already queued user delete messages
+func newFakeClaim(topic string, count int) (*fakeSession, *fakeClaim) {
+	messages := make(chan *sarama.ConsumerMessage, count)
+	for i := 0; i < count; i++ {
+		value, _ := json.Marshal(map[string]string{"command": "DELETE", "id": string(rune('0' + i))})
+		messages <- &sarama.ConsumerMessage{Topic: topic, Offset: int64(i), Value: value, Timestamp: time.Now()}
+	}
+	close(messages)
+	return &fakeSession{}, &fakeClaim{topic: topic, messages: messages}
+}
+
+type fakeClaim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+}
+
+func (this *fakeClaim) Topic() string                            { return this.topic }
+func (this *fakeClaim) Partition() int32                         { return 0 }
+func (this *fakeClaim) InitialOffset() int64                     { return 0 }
+func (this *fakeClaim) HighWaterMarkOffset() int64               { return 0 }
+func (this *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return this.messages }
+
+type fakeSession struct {
+	ctx    context.Context
+	marked []int64
+}
+
+func (this *fakeSession) Claims() map[string][]int32               { return nil }
+func (this *fakeSession) MemberID() string                         { return "" }
+func (this *fakeSession) GenerationID() int32                      { return 0 }
+func (this *fakeSession) MarkOffset(string, int32, int64, string)  {}
+func (this *fakeSession) Commit()                                  {}
+func (this *fakeSession) ResetOffset(string, int32, int64, string) {}
+func (this *fakeSession) Context() context.Context {
+	if this.ctx == nil {
+		return context.Background()
+	}
+	return this.ctx
+}
+func (this *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	this.marked = append(this.marked, msg.Offset)
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+)
+
+type DeadLetter struct {
+	Topic     string    `json:"topic"`
+	Partition int32     `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Key       []byte    `json:"key"`
+	Value     []byte    `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+	Error     string    `json:"error"`
+	Attempts  int64     `json:"attempts"`
+}
+
+type DeadLetterStore interface {
+	Store(letter DeadLetter) error
+}
+
+// KafkaDeadLetterStore produces dead letters to a kafka topic.
+// key and value of the original message are kept; the remaining information is passed as message headers.
+type KafkaDeadLetterStore struct {
+	topic    string
+	producer sarama.SyncProducer
+}
+
+func NewKafkaDeadLetterStore(ctx context.Context, wg *sync.WaitGroup, kafkaBootstrap string, topic string) (*KafkaDeadLetterStore, error) {
+	kafkaConf := sarama.NewConfig()
+	kafkaConf.Producer.Return.Successes = true
+	kafkaConf.Producer.RequiredAcks = sarama.WaitForAll
+	producer, err := sarama.NewSyncProducer(strings.Split(kafkaBootstrap, ","), kafkaConf)
+	if err != nil {
+		return nil, err
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		err := producer.Close()
+		if err != nil {
+			log.Logger.Error("unable to close dead letter producer", attributes.ErrorKey, err)
+		}
+	}()
+	return &KafkaDeadLetterStore{topic: topic, producer: producer}, nil
+}
+
+func (this *KafkaDeadLetterStore) Store(letter DeadLetter) error {
+	_, _, err := this.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: this.topic,
+		Key:   sarama.ByteEncoder(letter.Key),
+		Value: sarama.ByteEncoder(letter.Value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("original_topic"), Value: []byte(letter.Topic)},
+			{Key: []byte("original_partition"), Value: []byte(strconv.FormatInt(int64(letter.Partition), 10))},
+			{Key: []byte("original_offset"), Value: []byte(strconv.FormatInt(letter.Offset, 10))},
+			{Key: []byte("original_timestamp"), Value: []byte(letter.Timestamp.Format(time.RFC3339Nano))},
+			{Key: []byte("error"), Value: []byte(letter.Error)},
+			{Key: []byte("attempts"), Value: []byte(strconv.FormatInt(letter.Attempts, 10))},
+		},
+	})
+	return err
+}
+
+// FileDeadLetterStore appends dead letters as json lines to a local file
+type FileDeadLetterStore struct {
+	mux      sync.Mutex
+	location string
+}
+
+func NewFileDeadLetterStore(location string) (*FileDeadLetterStore, error) {
+	file, err := os.OpenFile(location, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileDeadLetterStore{location: location}, file.Close()
+}
+
+func (this *FileDeadLetterStore) Store(letter DeadLetter) error {
+	line, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	file, err := os.OpenFile(this.location, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+)
+
+const FailErrorPolicy = "fail"
+const DeadLetterErrorPolicy = "dead-letter"
+
+// ErrorPolicy decides how the Consumer reacts to listener errors.
+// failing messages are retried up to MaxRetries times with exponential backoff.
+// if all attempts fail, the message is written to DeadLetter and committed afterward.
+// without DeadLetter, the error is passed to the error handler of the consumer, which fails hard by default (see HandleError).
+type ErrorPolicy struct {
+	MaxRetries     int64
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	DeadLetter     DeadLetterStore
+}
+
+// FailHard is the original behaviour: no retries, no dead letters
+var FailHard = ErrorPolicy{}
+
+// Process calls handler with the message and applies the policy on errors.
+// a nil result means, that the message may be committed.
+func (this ErrorPolicy) Process(ctx context.Context, message *sarama.ConsumerMessage, handler func(message *sarama.ConsumerMessage) error) (err error) {
+	backoff := this.InitialBackoff
+	for attempt := int64(0); attempt <= this.MaxRetries; attempt++ {
+		if attempt > 0 {
+			log.Logger.Warn("retry kafka message", "topic", message.Topic, "offset", message.Offset, "attempt", attempt, attributes.ErrorKey, err)
+			select {
+			case <-ctx.Done():
+				return errors.Join(ctx.Err(), err)
+			case <-time.After(backoff):
+			}
+			backoff = this.nextBackoff(backoff)
+		}
+		err = handler(message)
+		if err == nil {
+			return nil
+		}
+	}
+	if this.DeadLetter == nil {
+		return err
+	}
+	deadLetterErr := this.DeadLetter.Store(DeadLetter{
+		Topic:     message.Topic,
+		Partition: message.Partition,
+		Offset:    message.Offset,
+		Key:       message.Key,
+		Value:     message.Value,
+		Timestamp: message.Timestamp,
+		Error:     err.Error(),
+		Attempts:  this.MaxRetries + 1,
+	})
+	if deadLetterErr != nil {
+		return fmt.Errorf("unable to store dead letter: %w (original error: %w)", deadLetterErr, err)
+	}
+	log.Logger.Error("kafka message moved to dead letters", "topic", message.Topic, "partition", message.Partition, "offset", message.Offset, attributes.ErrorKey, err)
+	return nil
+}
+
+func (this ErrorPolicy) nextBackoff(current time.Duration) time.Duration {
+	next := current * 2
+	if next <= 0 {
+		next = current
+	}
+	if this.MaxBackoff > 0 && next > this.MaxBackoff {
+		next = this.MaxBackoff
+	}
+	return next
+}
+
+func ErrorPolicyFromConfig(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (policy ErrorPolicy, err error) {
+	policy.MaxRetries = conf.ConsumerMaxRetries
+	if conf.ConsumerRetryBackoff != "" {
+		policy.InitialBackoff, err = time.ParseDuration(conf.ConsumerRetryBackoff)
+		if err != nil {
+			return policy, fmt.Errorf("invalid consumer_retry_backoff: %w", err)
+		}
+	}
+	if conf.ConsumerMaxRetryBackoff != "" {
+		policy.MaxBackoff, err = time.ParseDuration(conf.ConsumerMaxRetryBackoff)
+		if err != nil {
+			return policy, fmt.Errorf("invalid consumer_max_retry_backoff: %w", err)
+		}
+	}
+	switch conf.ConsumerErrorPolicy {
+	case "", FailErrorPolicy:
+		return policy, nil
+	case DeadLetterErrorPolicy:
+		switch {
+		case conf.DeadLetterTopic != "":
+			policy.DeadLetter, err = NewKafkaDeadLetterStore(ctx, wg, conf.KafkaBootstrap, conf.DeadLetterTopic)
+		case conf.DeadLetterFile != "":
+			policy.DeadLetter, err = NewFileDeadLetterStore(conf.DeadLetterFile)
+		default:
+			err = errors.New("consumer_error_policy 'dead-letter' needs dead_letter_topic or dead_letter_file")
+		}
+		return policy, err
+	default:
+		return policy, fmt.Errorf("unknown consumer_error_policy '%v'", conf.ConsumerErrorPolicy)
+	}
+}
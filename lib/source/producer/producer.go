@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/source"
+)
+
+const PutCommand = "PUT"
+const DeleteCommand = "DELETE"
+
+type Interface interface {
+	PublishImportType(importType model.ImportType) error
+	PublishImportTypeDelete(id string, owner string) error
+}
+
+type Producer struct {
+	topic    string
+	producer sarama.SyncProducer
+	debug    bool
+}
+
+// New creates a kafka producer for import type commands.
+// if no import type topic is configured, publishing is disabled and a Void producer is returned
+func New(conf config.Config, ctx context.Context, wg *sync.WaitGroup) (result Interface, err error) {
+	if conf.ImportTypeTopic == "" || conf.ImportTypeTopic == "-" {
+		log.Logger.Info("no import type topic configured, import type changes will not be published")
+		return Void{}, nil
+	}
+	kafkaConf := sarama.NewConfig()
+	kafkaConf.Producer.Return.Successes = true
+	kafkaConf.Producer.RequiredAcks = sarama.WaitForAll
+	kafkaConf.Producer.Partitioner = sarama.NewHashPartitioner
+	syncProducer, err := sarama.NewSyncProducer(strings.Split(conf.KafkaBootstrap, ","), kafkaConf)
+	if err != nil {
+		return nil, err
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		err := syncProducer.Close()
+		if err != nil {
+			log.Logger.Error("unable to close kafka producer", attributes.ErrorKey, err)
+		}
+	}()
+	return &Producer{topic: conf.ImportTypeTopic, producer: syncProducer, debug: conf.Debug}, nil
+}
+
+// PublishImportType publishes the import type without the default values of secret configs,
+// which are only readable by the owner and must not be distributed to all consumers of the topic
+func (this *Producer) PublishImportType(importType model.ImportType) error {
+	importType = model.WithoutSecretDefaults(importType)
+	importType.Etag = ""
+	return this.send(source.ImportTypeCommand{
+		Command:    PutCommand,
+		Id:         importType.Id,
+		Owner:      importType.Owner,
+		ImportType: model.ExtendImportType(importType),
+	})
+}
+
+func (this *Producer) PublishImportTypeDelete(id string, owner string) error {
+	return this.send(source.ImportTypeCommand{
+		Command: DeleteCommand,
+		Id:      id,
+		Owner:   owner,
+	})
+}
+
+func (this *Producer) send(command source.ImportTypeCommand) error {
+	message, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+	if this.debug {
+		log.Logger.Debug("publish import type command", "topic", this.topic, "command", command.Command, "id", command.Id)
+	}
+	_, _, err = this.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: this.topic,
+		Key:   sarama.StringEncoder(command.Id),
+		Value: sarama.ByteEncoder(message),
+	})
+	return err
+}
+
+// Void is used if publishing is disabled
+type Void struct{}
+
+func (Void) PublishImportType(model.ImportType) error {
+	return nil
+}
+
+func (Void) PublishImportTypeDelete(string, string) error {
+	return nil
+}
@@ -18,7 +18,12 @@ package docker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
 	"github.com/SENERGY-Platform/import-repository/lib/log"
@@ -31,6 +36,7 @@ func MongoDB(ctx context.Context, wg *sync.WaitGroup) (hostport string, containe
 	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: testcontainers.ContainerRequest{
 			Image:        "mongo:4.1.11",
+			Cmd:          []string{"--replSet", "rs0"}, //single node replica set to support transactions
 			ExposedPorts: []string{"27017/tcp"},
 			WaitingFor: wait.ForAll(
 				wait.ForLog("waiting for connections"),
@@ -66,5 +72,34 @@ func MongoDB(ctx context.Context, wg *sync.WaitGroup) (hostport string, containe
 	}
 	hostport = temp.Port()
 
+	err = initReplicaSet(ctx, c, containerip+":27017")
+	if err != nil {
+		return "", "", err
+	}
+
 	return hostport, containerip, err
 }
+
+func initReplicaSet(ctx context.Context, c testcontainers.Container, host string) error {
+	code, _, err := c.Exec(ctx, []string{"mongo", "--quiet", "--eval", `rs.initiate({_id: "rs0", members: [{_id: 0, host: "` + host + `"}]})`})
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return fmt.Errorf("unable to initiate replica set: exit code %v", code)
+	}
+	return retry(time.Minute, func() error {
+		code, reader, err := c.Exec(ctx, []string{"mongo", "--quiet", "--eval", "db.isMaster().ismaster"})
+		if err != nil {
+			return err
+		}
+		output, err := io.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		if code != 0 || !strings.Contains(string(output), "true") {
+			return errors.New("replica set has no primary yet")
+		}
+		return nil
+	})
+}
@@ -17,35 +17,10 @@
 package mocks
 
 import (
-	"context"
-	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/database/memory"
 )
 
-type Database struct {
-	importTypes map[string]model.ImportType
-}
-
-func NewDatabase() *Database {
-	return &Database{
-		importTypes: map[string]model.ImportType{},
-	}
-}
-
-func (this *Database) Disconnect() {
-	return
-}
-
-func (this *Database) GetImportType(ctx context.Context, id string) (importType model.ImportType, exists bool, err error) {
-	importType, exists = this.importTypes[id]
-	return
-}
-
-func (this *Database) SetImportType(ctx context.Context, importType model.ImportType) error {
-	this.importTypes[importType.Id] = importType
-	return nil
-}
-
-func (this *Database) RemoveImportType(ctx context.Context, id string) error {
-	delete(this.importTypes, id)
-	return nil
+// NewDatabase returns an empty in-memory database, which implements database.Database
+func NewDatabase() *memory.Memory {
+	return memory.New()
 }
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"sync"
+
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/source"
+	"github.com/SENERGY-Platform/import-repository/lib/source/producer"
+)
+
+// Producer records published import type commands in memory
+type Producer struct {
+	mux      sync.Mutex
+	messages []source.ImportTypeCommand
+}
+
+func NewProducer() *Producer {
+	return &Producer{}
+}
+
+func (this *Producer) PublishImportType(importType model.ImportType) error {
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	importType = model.WithoutSecretDefaults(importType)
+	importType.Etag = ""
+	this.messages = append(this.messages, source.ImportTypeCommand{
+		Command:    producer.PutCommand,
+		Id:         importType.Id,
+		Owner:      importType.Owner,
+		ImportType: model.ExtendImportType(importType),
+	})
+	return nil
+}
+
+func (this *Producer) PublishImportTypeDelete(id string, owner string) error {
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	this.messages = append(this.messages, source.ImportTypeCommand{
+		Command: producer.DeleteCommand,
+		Id:      id,
+		Owner:   owner,
+	})
+	return nil
+}
+
+// Messages returns a copy of all published commands in publishing order
+func (this *Producer) Messages() []source.ImportTypeCommand {
+	this.mux.Lock()
+	defer this.mux.Unlock()
+	result := make([]source.ImportTypeCommand, len(this.messages))
+	copy(result, this.messages)
+	return result
+}
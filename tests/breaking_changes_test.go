@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestBreakingChanges(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:  "breaking",
+		Image: "image",
+		Output: model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+			{Name: "value", Type: model.Float},
+			{Name: "unit", Type: model.String},
+		}},
+		Configs: []model.ImportConfig{{Name: "interval", Type: model.Integer, DefaultValue: 10}},
+	}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	breaking := importType
+	breaking.Output.SubContentVariables = breaking.Output.SubContentVariables[:1]
+
+	t.Run("diff against body", func(t *testing.T) {
+		result, err, _ := c.DiffImportType(importType.Id, breaking, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Breaking || len(result.Changes) != 1 || result.Changes[0].Path != "/output/sub_content_variables/1" || result.Changes[0].Kind != model.ChangeRemoved {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("reject breaking update", func(t *testing.T) {
+		err, code := c.SetImportType(breaking, userjwt)
+		var breakingErr *model.BreakingChangesError
+		if code != http.StatusConflict || !errors.As(err, &breakingErr) || len(breakingErr.Changes) != 1 {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("compatible update", func(t *testing.T) {
+		compatible := importType
+		compatible.Description = "compatible"
+		err, _ := c.SetImportType(compatible, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("forced update", func(t *testing.T) {
+		_, err, _ := c.SetImportTypeWithOptions(breaking, true, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("diff against revision", func(t *testing.T) {
+		result, err, _ := c.DiffImportTypeRevision(importType.Id, 1, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Breaking || len(result.Changes) != 1 || result.Changes[0].Path != "/output/sub_content_variables/1" {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("version bump", func(t *testing.T) {
+		current, err, _ := c.ReadImportType(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		current.Configs = nil
+		_, code := c.SetImportType(current, userjwt)
+		if code != http.StatusConflict {
+			t.Error(code)
+			return
+		}
+		current.Releases = []model.ImportTypeRelease{{Version: "1.0.0", Image: "import:1.0.0", Channel: model.ReleaseChannelStable}}
+		err, _ = c.SetImportType(current, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,359 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib"
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/controller"
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/docker"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/mocks"
+	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
+)
+
+func TestBulk(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	toUpdate, err := createImportType(conf, model.ImportType{Name: "bulk-update", Image: "image"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	toDelete, err := createImportType(conf, model.ImportType{Name: "bulk-delete", Image: "image"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("non atomic", func(t *testing.T) {
+		update := toUpdate
+		update.Name = "bulk-update-2"
+		result, err, code := c.BulkImportTypes([]model.ImportTypeBulkOperation{
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-create", Image: "image"}},
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Id: "explicit", Name: "bulk-invalid", Image: "image"}},
+			{Operation: model.BulkUpdate, ImportType: &update},
+			{Operation: model.BulkDelete, Id: toDelete.Id},
+			{Operation: model.BulkDelete, Id: toDelete.Id},
+		}, false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if code != http.StatusOK {
+			t.Error(code)
+		}
+		expectedCodes := []int{http.StatusCreated, http.StatusBadRequest, http.StatusOK, http.StatusNoContent, http.StatusBadRequest}
+		if len(result) != len(expectedCodes) {
+			t.Errorf("%#v", result)
+			return
+		}
+		for i, expected := range expectedCodes {
+			if result[i].Code != expected {
+				t.Errorf("%v: %#v", i, result[i])
+			}
+		}
+
+		created, err, _ := c.ReadImportType(result[0].Id, userjwt)
+		if err != nil {
+			t.Error(err)
+		} else if created.Name != "bulk-create" || created.Owner == "" {
+			t.Errorf("%#v", created)
+		}
+		updated, err, _ := c.ReadImportType(toUpdate.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+		} else if updated.Name != "bulk-update-2" {
+			t.Errorf("%#v", updated)
+		}
+		_, err, _ = c.ReadImportType(toDelete.Id, userjwt)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("atomic rollback", func(t *testing.T) {
+		update, err, _ := c.ReadImportType(toUpdate.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		update.Name = "bulk-update-3"
+		update.Etag = "outdated"
+		result, err, code := c.BulkImportTypes([]model.ImportTypeBulkOperation{
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-atomic-create", Image: "image"}},
+			{Operation: model.BulkUpdate, ImportType: &update},
+		}, true, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if code != http.StatusPreconditionFailed {
+			t.Error(code)
+		}
+		if len(result) != 2 || result[0].Code != http.StatusFailedDependency || result[1].Code != http.StatusPreconditionFailed {
+			t.Errorf("%#v", result)
+			return
+		}
+		_, err, _ = c.ReadImportType(result[0].Id, userjwt)
+		if err == nil {
+			t.Error("expected error")
+		}
+		current, err, _ := c.ReadImportType(toUpdate.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+		} else if current.Name != "bulk-update-2" {
+			t.Errorf("%#v", current)
+		}
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		update, err, _ := c.ReadImportType(toUpdate.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		update.Name = "bulk-update-4"
+		result, err, code := c.BulkImportTypes([]model.ImportTypeBulkOperation{
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-atomic-create-1", Image: "image"}},
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-atomic-create-2", Image: "image"}},
+			{Operation: model.BulkUpdate, ImportType: &update},
+		}, true, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if code != http.StatusOK {
+			t.Error(code)
+		}
+		if len(result) != 3 || result[0].Code != http.StatusCreated || result[1].Code != http.StatusCreated || result[2].Code != http.StatusOK {
+			t.Errorf("%#v", result)
+			return
+		}
+		for _, r := range result {
+			_, err, _ = c.ReadImportType(r.Id, userjwt)
+			if err != nil {
+				t.Error(err)
+			}
+		}
+		revisions, _, err, _ := c.ListImportTypeRevisions(toUpdate.Id, userjwt, model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+		} else if len(revisions) == 0 || revisions[0].ImportType.Name != "bulk-update-4" {
+			t.Errorf("%#v", revisions)
+		}
+	})
+}
+
+// failingPermissions fails the n-th call of SetPermission and, if failList is set, every ListResourcesWithAdminPermission call
+type failingPermissions struct {
+	permV2.Client
+	mux      sync.Mutex
+	calls    int
+	n        int
+	failList bool
+}
+
+func (this *failingPermissions) ListResourcesWithAdminPermission(token string, topicId string, options permV2.ListOptions) (result []permV2.Resource, err error, code int) {
+	this.mux.Lock()
+	fail := this.failList
+	this.mux.Unlock()
+	if fail {
+		return result, errors.New("test error"), http.StatusInternalServerError
+	}
+	return this.Client.ListResourcesWithAdminPermission(token, topicId, options)
+}
+
+func (this *failingPermissions) SetPermission(token string, topicId string, id string, permissions permV2.ResourcePermissions) (result permV2.ResourcePermissions, err error, code int) {
+	this.mux.Lock()
+	this.calls++
+	fail := this.calls == this.n
+	this.mux.Unlock()
+	if fail {
+		return result, errors.New("test error"), http.StatusInternalServerError
+	}
+	return this.Client.SetPermission(token, topicId, id, permissions)
+}
+
+func TestAtomicBulkPermissionsRollback(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.DatabaseBackend = database.BackendMemory
+	port, err := docker.GetFreePort()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.ServerPort = strconv.Itoa(port)
+	testClient, err := permV2.NewTestClient(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	// the two import types created before the bulk request succeed, the second create of the bulk request fails
+	permissions := &failingPermissions{Client: testClient, n: 4}
+	producer := mocks.NewProducer()
+	err = lib.StartWithDependencies(conf, ctx, wg, permissions, producer)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	time.Sleep(2 * time.Second)
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	toUpdate, err, _ := c.CreateImportType(model.ImportType{Name: "bulk-update", Image: "image"}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	toDelete, err, _ := c.CreateImportType(model.ImportType{Name: "bulk-delete", Image: "image"}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	update := toUpdate
+	update.Name = "bulk-update-2"
+	result, err, code := c.BulkImportTypes([]model.ImportTypeBulkOperation{
+		{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-create-1", Image: "image"}},
+		{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-create-2", Image: "image"}},
+		{Operation: model.BulkUpdate, ImportType: &update},
+		{Operation: model.BulkDelete, Id: toDelete.Id},
+	}, true, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if code != http.StatusInternalServerError || len(result) != 4 || result[1].Code != http.StatusInternalServerError {
+		t.Errorf("%v %#v", code, result)
+		return
+	}
+	for _, i := range []int{0, 2, 3} {
+		if result[i].Code != http.StatusFailedDependency {
+			t.Errorf("%v: %#v", i, result[i])
+		}
+	}
+
+	t.Run("created import types are removed", func(t *testing.T) {
+		for _, created := range result[:2] {
+			_, _, code := c.ReadImportType(created.Id, userjwt)
+			if code != http.StatusForbidden && code != http.StatusNotFound {
+				t.Error(created.Id, code)
+			}
+			_, err, _ := permissions.GetResource(permV2.InternalAdminToken, controller.PermV2Topic, created.Id)
+			if err == nil {
+				t.Error("permissions not removed", created.Id)
+			}
+		}
+	})
+
+	t.Run("update is reverted", func(t *testing.T) {
+		current, err, _ := c.ReadImportType(toUpdate.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if current.Name != "bulk-update" {
+			t.Errorf("%#v", current)
+		}
+		revisions, total, err, _ := c.ListImportTypeRevisions(toUpdate.Id, userjwt, model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 1 || revisions[0].ImportType.Name != "bulk-update" {
+			t.Errorf("%#v", revisions)
+		}
+	})
+
+	t.Run("delete is reverted", func(t *testing.T) {
+		_, err, _ := c.ReadImportType(toDelete.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+		list, _, err, _ := c.ListTrashedImportTypes(userjwt, model.TrashListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(list) != 0 {
+			t.Errorf("%#v", list)
+		}
+	})
+
+	t.Run("nothing published", func(t *testing.T) {
+		// messages of the two import types created before the bulk request
+		if messages := producer.Messages(); len(messages) != 2 {
+			t.Errorf("%#v", messages)
+		}
+	})
+
+	t.Run("trash lookup failure", func(t *testing.T) {
+		permissions.mux.Lock()
+		permissions.failList = true
+		permissions.mux.Unlock()
+		defer func() {
+			permissions.mux.Lock()
+			permissions.failList = false
+			permissions.mux.Unlock()
+		}()
+		// the lookup is one request for all delete operations, so the failure is not reported for an unrelated operation
+		result, _, code := c.BulkImportTypes([]model.ImportTypeBulkOperation{
+			{Operation: model.BulkCreate, ImportType: &model.ImportType{Name: "bulk-create-3", Image: "image"}},
+			{Operation: model.BulkDelete, Id: toDelete.Id},
+		}, true, userjwt)
+		if code != http.StatusInternalServerError || len(result) != 0 {
+			t.Errorf("%v %#v", code, result)
+		}
+		_, err, _ := c.ReadImportType(toDelete.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+}
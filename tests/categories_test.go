@@ -0,0 +1,172 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestTagsAndCategories(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	user1, err := createToken("test", "user1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	user2, err := createToken("test", "user2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("only admins manage categories", func(t *testing.T) {
+		_, err, code := c.SetImportTypeCategory(model.ImportTypeCategory{Id: "weather", Name: "Weather"}, user1)
+		if err == nil || code != http.StatusForbidden {
+			t.Error(err, code)
+		}
+	})
+
+	weather := model.ImportTypeCategory{Id: "weather", Name: "Weather"}
+	energy := model.ImportTypeCategory{Id: "energy", Name: "Energy prices", Description: "day-ahead prices"}
+	for _, category := range []model.ImportTypeCategory{weather, energy} {
+		_, err, _ = c.SetImportTypeCategory(category, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	t.Run("list categories", func(t *testing.T) {
+		categories, err, _ := c.ListImportTypeCategories(user1)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(categories, []model.ImportTypeCategory{energy, weather}) {
+			t.Errorf("%#v", categories)
+		}
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		_, err, code := c.CreateImportType(model.ImportType{Name: "unknown", Category: "unknown"}, user1)
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		_, err, code := c.CreateImportType(model.ImportType{Name: "invalid", Tags: []string{"a", "a"}}, user1)
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+
+	rain, err, _ := c.CreateImportType(model.ImportType{Name: "rain", Category: weather.Id, Tags: []string{"dwd", "rain"}}, user1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	wind, err, _ := c.CreateImportType(model.ImportType{Name: "wind", Category: weather.Id, Tags: []string{"dwd"}}, user1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	prices, err, _ := c.CreateImportType(model.ImportType{Name: "prices", Category: energy.Id, Tags: []string{"entsoe", "rain"}}, user1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err, _ = c.CreateImportType(model.ImportType{Name: "other", Tags: []string{"dwd"}}, user2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("filter tags", testImportTypesList(c, client.ImportTypeListOptions{Tags: []string{"dwd", "rain"}}, []model.ImportType{rain}))
+	t.Run("filter categories", testImportTypesList(c, client.ImportTypeListOptions{Categories: []string{weather.Id, energy.Id}}, []model.ImportType{prices, rain, wind}))
+	t.Run("filter tags and category", testImportTypesList(c, client.ImportTypeListOptions{Tags: []string{"rain"}, Categories: []string{energy.Id}}, []model.ImportType{prices}))
+
+	t.Run("tag counts", func(t *testing.T) {
+		tags, err, _ := c.ListImportTypeTags(user1, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected := []model.ImportTypeTagCount{{Tag: "dwd", Count: 2}, {Tag: "rain", Count: 2}, {Tag: "entsoe", Count: 1}}
+		if !reflect.DeepEqual(tags, expected) {
+			t.Errorf("\n%#v\n%#v", tags, expected)
+		}
+		tags, err, _ = c.ListImportTypeTags(user1, model.ImportTypeListOptions{Categories: []string{weather.Id}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		expected = []model.ImportTypeTagCount{{Tag: "dwd", Count: 2}, {Tag: "rain", Count: 1}}
+		if !reflect.DeepEqual(tags, expected) {
+			t.Errorf("\n%#v\n%#v", tags, expected)
+		}
+	})
+
+	t.Run("delete used category", func(t *testing.T) {
+		err, code := c.DeleteImportTypeCategory(energy.Id, userjwt)
+		if err == nil || code != http.StatusConflict {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("delete unused category", func(t *testing.T) {
+		err, _ = c.DeleteImportType(prices.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		err, _ = c.DeleteImportTypeCategory(energy.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		categories, err, _ := c.ListImportTypeCategories(user1)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(categories, []model.ImportTypeCategory{weather}) {
+			t.Errorf("%#v", categories)
+		}
+	})
+}
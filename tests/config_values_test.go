@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+func TestConfigValues(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	owner, err := createToken("test", "owner")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	other, err := createToken("test", "other")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	minimum := float64(1)
+	maximum := float64(60)
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:   "config values",
+		Image:  "image",
+		Output: model.ContentVariable{Name: "output", Type: model.String},
+		Configs: []model.ImportConfig{
+			{Name: "interval", Type: model.Integer, DefaultValue: 10, Minimum: &minimum, Maximum: &maximum},
+			{Name: "unit", Type: model.String, Enum: []interface{}{"s", "m"}, Required: true},
+			{Name: "api_key", Type: model.String, DefaultValue: "secret", Secret: true},
+		},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	_, err, _ = c.SetImportTypePermissions(importType.Id, permV2Model.ResourcePermissions{
+		UserPermissions: map[string]permV2Model.PermissionsMap{
+			owner.GetUserId(): {Read: true, Write: true, Execute: true, Administrate: true},
+			other.GetUserId(): {Read: true, Write: true},
+		},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("owner reads secret default", func(t *testing.T) {
+		result, err, _ := c.ReadImportType(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Configs[2].DefaultValue != "secret" {
+			t.Errorf("%#v", result.Configs[2])
+		}
+	})
+
+	t.Run("other user update keeps secret default", func(t *testing.T) {
+		result, err, _ := c.ReadImportType(importType.Id, other)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Configs[2].DefaultValue != nil {
+			t.Errorf("%#v", result.Configs[2])
+			return
+		}
+		result.Description = "updated"
+		err, _ = c.SetImportType(result, other)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		result, err, _ = c.ReadImportType(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Description != "updated" || result.Configs[2].DefaultValue != "secret" {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("valid values", func(t *testing.T) {
+		result, err, _ := c.ValidateImportTypeConfigValues(importType.Id, map[string]interface{}{"unit": "s", "interval": 30}, other)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Valid {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		result, err, _ := c.ValidateImportTypeConfigValues(importType.Id, map[string]interface{}{"interval": 120, "foo": true}, other)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		codes := map[string]model.ValidationFindingCode{}
+		for _, finding := range result.Findings {
+			codes[finding.Path] = finding.Code
+		}
+		if result.Valid || codes["/interval"] != model.ValidationInvalidValue || codes["/unit"] != model.ValidationRequired || codes["/foo"] != model.ValidationNotAllowed {
+			t.Errorf("%#v", result)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestCursorPagination(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	// duplicate names ensure that the id is used to order elements with the same sort value
+	for i := 0; i < 25; i++ {
+		_, err, _ = c.CreateImportType(model.ImportType{Name: "cursor-" + strconv.Itoa(i%5), Image: "image"}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		first, total, next, err, _ := c.ListImportTypesPage(userjwt, model.ImportTypeListOptions{Limit: 10, SortBy: "name.desc"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 25 || len(first) != 10 || next == nil {
+			t.Error(total, len(first), next)
+			return
+		}
+
+		// an import type inserted in front of the cursor position must not shift the following pages
+		_, err, _ = c.CreateImportType(model.ImportType{Name: "cursor-9", Image: "image"}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		second, total, next, err, _ := c.ListImportTypesPage(userjwt, model.ImportTypeListOptions{Limit: 10, SortBy: "name.desc", After: next, WithoutTotal: true})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != -1 || len(second) != 10 || next == nil {
+			t.Error(total, len(second), next)
+			return
+		}
+		last := first[len(first)-1]
+		if second[0].Name > last.Name || (second[0].Name == last.Name && second[0].Id >= last.Id) {
+			t.Errorf("%#v %#v", last, second[0])
+		}
+		third, _, next, err, _ := c.ListImportTypesPage(userjwt, model.ImportTypeListOptions{Limit: 10, SortBy: "name.desc", After: next})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(third) != 5 || next != nil {
+			t.Error(len(third), next)
+		}
+	})
+
+	t.Run("iterate", func(t *testing.T) {
+		seen := map[string]bool{}
+		previous := model.ImportType{}
+		for importType, err := range c.IterateImportTypes(userjwt, model.ImportTypeListOptions{Limit: 7, SortBy: "name.asc"}) {
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if seen[importType.Id] {
+				t.Error("duplicate", importType.Id)
+			}
+			seen[importType.Id] = true
+			if importType.Name < previous.Name || (importType.Name == previous.Name && importType.Id < previous.Id) {
+				t.Errorf("unexpected order %#v %#v", previous, importType)
+			}
+			previous = importType
+		}
+		if len(seen) != 26 {
+			t.Error(len(seen))
+		}
+	})
+
+	t.Run("cursor with other sort", func(t *testing.T) {
+		_, _, next, err, _ := c.ListImportTypesPage(userjwt, model.ImportTypeListOptions{Limit: 10, SortBy: "name.asc"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, _, _, err, code := c.ListImportTypesPage(userjwt, model.ImportTypeListOptions{Limit: 10, SortBy: "name.desc", After: next})
+		if err == nil || code != 400 {
+			t.Error(err, code)
+		}
+	})
+}
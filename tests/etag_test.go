@@ -0,0 +1,149 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestEtag(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	created, err := createImportType(conf, model.ImportType{Name: "etag", Image: "image"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	first, err, _ := c.ReadImportType(created.Id, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if first.Etag == "" {
+		t.Error("missing etag")
+		return
+	}
+
+	t.Run("list with etag", func(t *testing.T) {
+		list, _, err, _ := c.ListImportTypes(userjwt, client.ImportTypeListOptions{Ids: []string{created.Id}, WithEtag: true})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(list) != 1 || list[0].Etag != first.Etag {
+			t.Errorf("%#v", list)
+		}
+	})
+
+	t.Run("if-none-match", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:"+conf.ServerPort+"/import-types/"+url.PathEscape(created.Id), nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		req.Header.Set("Authorization", userjwt.Jwt())
+		req.Header.Set("If-None-Match", strconv.Quote(first.Etag))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotModified {
+			t.Error(resp.StatusCode)
+		}
+	})
+
+	t.Run("update with matching etag", func(t *testing.T) {
+		update := first
+		update.Name = "etag2"
+		// the stored image is resolved from the release, so the stored version differs from the request body
+		update.Releases = []model.ImportTypeRelease{{Version: "1.0.0", Image: "release-image", Channel: model.ReleaseChannelStable}}
+		result, err, _ := c.SetImportTypeWithOptions(update, false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		current, err, _ := c.ReadImportType(created.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Image != "release-image" || result.Etag == "" || result.Etag != current.Etag {
+			t.Errorf("%#v\n%#v", result, current)
+			return
+		}
+		err, _ = c.SetImportType(result, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("update with outdated etag", func(t *testing.T) {
+		update := first
+		update.Name = "etag3"
+		_, code := c.SetImportType(update, userjwt)
+		if code != http.StatusPreconditionFailed {
+			t.Error(code)
+		}
+	})
+
+	t.Run("delete with outdated etag", func(t *testing.T) {
+		_, code := c.DeleteImportTypeIfMatch(created.Id, first.Etag, userjwt)
+		if code != http.StatusPreconditionFailed {
+			t.Error(code)
+		}
+	})
+
+	t.Run("delete with current etag", func(t *testing.T) {
+		current, err, _ := c.ReadImportType(created.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if current.Name != "etag2" || current.Etag == first.Etag {
+			t.Errorf("%#v", current)
+			return
+		}
+		err, _ = c.DeleteImportTypeIfMatch(created.Id, current.Etag, userjwt)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+}
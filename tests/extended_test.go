@@ -0,0 +1,86 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestExtended(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	created, err := createImportType(conf, model.ImportType{
+		Name:  "extended",
+		Image: "image",
+		Output: model.ContentVariable{
+			Name: "output",
+			Type: model.Structure,
+			SubContentVariables: []model.ContentVariable{
+				{Name: "value", Type: model.Float, AspectId: "aspect", FunctionId: "function"},
+			},
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("read", func(t *testing.T) {
+		result, err, _ := c.ReadImportTypeExtended(created.Id, userjwt, false)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Id != created.Id ||
+			!slices.Equal(result.ContentAspectIds, []string{"aspect"}) ||
+			!slices.Equal(result.ContentFunctionIds, []string{"function"}) ||
+			!slices.Equal(result.AspectFunctions, []string{"aspect_function"}) ||
+			result.References != nil {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		result, total, err, _ := c.ListImportTypesExtended(userjwt, model.ImportTypeListOptions{Ids: []string{created.Id}}, false)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 1 || len(result) != 1 || !slices.Equal(result[0].ContentFunctionIds, []string{"function"}) {
+			t.Errorf("%v %#v", total, result)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestFacets(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	user1, err := createToken("test", "user1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	user2, err := createToken("test", "user2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	getColorFunction := "urn:infai:ses:measuring-function:getColorFunction"
+	getHumidityFunction := "urn:infai:ses:measuring-function:getHumidityFunction"
+	testCharacteristic := "urn:infai:ses:characteristic:test"
+	deviceAspect := "urn:infai:ses:aspect:deviceAspect"
+	airAspect := "urn:infai:ses:aspect:airAspect"
+
+	// the color function is used twice by the first import type, which must still be counted once
+	_, err, _ = c.CreateImportType(model.ImportType{Name: "facet color humidity", Cost: 5, Output: model.ContentVariable{
+		Name: "output",
+		SubContentVariables: []model.ContentVariable{
+			{Name: "a", FunctionId: getColorFunction, AspectId: deviceAspect, CharacteristicId: testCharacteristic},
+			{Name: "b", FunctionId: getColorFunction, AspectId: deviceAspect},
+			{Name: "c", FunctionId: getHumidityFunction, AspectId: airAspect},
+		},
+	}}, user1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err, _ = c.CreateImportType(model.ImportType{Name: "facet color", Cost: 0, Output: model.ContentVariable{
+		Name: "output",
+		SubContentVariables: []model.ContentVariable{
+			{Name: "a", FunctionId: getColorFunction, AspectId: deviceAspect},
+		},
+	}}, user1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err, _ = c.CreateImportType(model.ImportType{Name: "facet humidity", Cost: 2000, Output: model.ContentVariable{
+		Name: "output",
+		SubContentVariables: []model.ContentVariable{
+			{Name: "a", FunctionId: getHumidityFunction, AspectId: airAspect},
+		},
+	}}, user2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("user1", func(t *testing.T) {
+		facets, err, _ := c.GetImportTypeFacets(user1, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(facets.Functions, []model.FacetCount{{Value: getColorFunction, Count: 2}, {Value: getHumidityFunction, Count: 1}}) {
+			t.Errorf("%#v", facets.Functions)
+		}
+		if !reflect.DeepEqual(facets.Aspects, []model.FacetCount{{Value: deviceAspect, Count: 2}, {Value: airAspect, Count: 1}}) {
+			t.Errorf("%#v", facets.Aspects)
+		}
+		if !reflect.DeepEqual(facets.Characteristics, []model.FacetCount{{Value: testCharacteristic, Count: 1}}) {
+			t.Errorf("%#v", facets.Characteristics)
+		}
+		if !reflect.DeepEqual(facets.Owners, []model.FacetCount{{Value: user1.GetUserId(), Count: 2}}) {
+			t.Errorf("%#v", facets.Owners)
+		}
+		if !reflect.DeepEqual(costCounts(facets), []int64{1, 1, 0, 0, 0}) {
+			t.Errorf("%#v", facets.Costs)
+		}
+	})
+
+	t.Run("admin with search", func(t *testing.T) {
+		facets, err, _ := c.GetImportTypeFacets(userjwt, model.ImportTypeListOptions{Search: "humidity"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(facets.Functions, []model.FacetCount{{Value: getHumidityFunction, Count: 2}, {Value: getColorFunction, Count: 1}}) {
+			t.Errorf("%#v", facets.Functions)
+		}
+		if !reflect.DeepEqual(costCounts(facets), []int64{0, 1, 0, 0, 1}) {
+			t.Errorf("%#v", facets.Costs)
+		}
+	})
+
+	t.Run("criteria", func(t *testing.T) {
+		facets, err, _ := c.GetImportTypeFacets(userjwt, model.ImportTypeListOptions{Criteria: []model.ImportTypeFilterCriteria{{FunctionId: getHumidityFunction}}})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(facets.Owners, []model.FacetCount{{Value: user1.GetUserId(), Count: 1}, {Value: user2.GetUserId(), Count: 1}}) {
+			t.Errorf("%#v", facets.Owners)
+		}
+	})
+}
+
+func costCounts(facets model.ImportTypeFacets) (result []int64) {
+	for _, bucket := range facets.Costs {
+		result = append(result, bucket.Count)
+	}
+	return result
+}
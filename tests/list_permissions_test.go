@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestListPermissionFilter(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	user1, err := createToken("test", "user1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	user2, err := createToken("test", "user2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	user1Ids := []string{}
+	user2Ids := []string{}
+	for i := 0; i < 30; i++ {
+		token := user1
+		if i%3 == 0 {
+			token = user2
+		}
+		created, err, _ := c.CreateImportType(model.ImportType{Name: "list-" + strconv.Itoa(i), Image: "image"}, token)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if token.GetUserId() == user1.GetUserId() {
+			user1Ids = append(user1Ids, created.Id)
+		} else {
+			user2Ids = append(user2Ids, created.Id)
+		}
+	}
+
+	t.Run("non admin sees only accessible import types", func(t *testing.T) {
+		list, total, err, _ := c.ListImportTypes(user2, model.ImportTypeListOptions{Limit: 100})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != int64(len(user2Ids)) || len(list) != len(user2Ids) {
+			t.Error(total, len(list))
+			return
+		}
+		for _, element := range list {
+			if !slices.Contains(user2Ids, element.Id) {
+				t.Error("unexpected import type", element.Id)
+			}
+		}
+	})
+
+	t.Run("non admin pagination", func(t *testing.T) {
+		list, total, err, _ := c.ListImportTypes(user1, model.ImportTypeListOptions{Limit: 5, Offset: 15})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != int64(len(user1Ids)) || len(list) != 5 {
+			t.Error(total, len(list))
+		}
+		count := 0
+		for importType, err := range c.IterateImportTypes(user1, model.ImportTypeListOptions{Limit: 6}) {
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !slices.Contains(user1Ids, importType.Id) {
+				t.Error("unexpected import type", importType.Id)
+			}
+			count++
+		}
+		if count != len(user1Ids) {
+			t.Error(count)
+		}
+	})
+
+	t.Run("non admin with ids", func(t *testing.T) {
+		requested := append(slices.Clone(user1Ids[:3]), user2Ids[:3]...)
+		list, total, err, _ := c.ListImportTypes(user2, model.ImportTypeListOptions{Ids: requested, Limit: 2})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 3 || len(list) != 2 {
+			t.Error(total, len(list))
+			return
+		}
+		for _, element := range list {
+			if !slices.Contains(user2Ids, element.Id) {
+				t.Error("unexpected import type", element.Id)
+			}
+		}
+	})
+
+	t.Run("admin with ids keeps pagination", func(t *testing.T) {
+		list, total, err, _ := c.ListImportTypes(userjwt, model.ImportTypeListOptions{Ids: user1Ids, Limit: 4, Offset: 2})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != int64(len(user1Ids)) || len(list) != 4 {
+			t.Error(total, len(list))
+		}
+	})
+}
@@ -134,7 +134,7 @@ func TestList(t *testing.T) {
 
 	t.Run("limit offset", testImportTypesList(c, client.ImportTypeListOptions{Limit: 2, Offset: 1}, []model.ImportType{it2, it3}))
 
-	t.Run("search it", testImportTypesList(c, client.ImportTypeListOptions{Search: "it"}, []model.ImportType{it1, it2, it3}))
+	t.Run("search it", testImportTypesList(c, client.ImportTypeListOptions{Search: "it", SearchMode: model.SearchModePrefix}, []model.ImportType{it1, it2, it3}))
 
 	t.Run("search none", testImportTypesList(c, client.ImportTypeListOptions{Search: "none"}, []model.ImportType{itNone}))
 
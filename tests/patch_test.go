@@ -0,0 +1,126 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestPatch(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	created, err := createImportType(conf, model.ImportType{Name: "patch", Description: "desc", Image: "image", DefaultRestart: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	first, err, _ := c.ReadImportType(created.Id, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	var merged model.ImportType
+	t.Run("merge patch", func(t *testing.T) {
+		merged, err, _ = c.PatchImportType(created.Id, model.MergePatch, []byte(`{"name":"patch2","description":null}`), first.Etag, false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if merged.Name != "patch2" || merged.Description != "" || merged.Image != "image" || !merged.DefaultRestart {
+			t.Errorf("%#v", merged)
+		}
+		if merged.Etag == "" || merged.Etag == first.Etag {
+			t.Error("missing new etag", merged.Etag)
+		}
+	})
+
+	t.Run("json patch", func(t *testing.T) {
+		result, err, _ := c.PatchImportType(created.Id, model.JsonPatch, []byte(`[{"op":"replace","path":"/image","value":"image2"},{"op":"replace","path":"/default_restart","value":false}]`), "", false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Name != "patch2" || result.Image != "image2" || result.DefaultRestart {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("patch returns stored version", func(t *testing.T) {
+		result, err, _ := c.PatchImportType(created.Id, model.MergePatch, []byte(`{"releases":[{"version":"1.0.0","image":"release-image","channel":"stable"}]}`), "", false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		current, err, _ := c.ReadImportType(created.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Image != "release-image" || result.Etag == "" || result.Etag != current.Etag {
+			t.Errorf("%#v\n%#v", result, current)
+		}
+	})
+
+	t.Run("outdated etag", func(t *testing.T) {
+		_, _, code := c.PatchImportType(created.Id, model.MergePatch, []byte(`{"name":"patch3"}`), merged.Etag, false, userjwt)
+		if code != http.StatusPreconditionFailed {
+			t.Error(code)
+		}
+	})
+
+	t.Run("change id", func(t *testing.T) {
+		_, _, code := c.PatchImportType(created.Id, model.JsonPatch, []byte(`[{"op":"replace","path":"/id","value":"other"}]`), "", false, userjwt)
+		if code != http.StatusBadRequest {
+			t.Error(code)
+		}
+	})
+
+	t.Run("failing test op", func(t *testing.T) {
+		_, _, code := c.PatchImportType(created.Id, model.JsonPatch, []byte(`[{"op":"test","path":"/name","value":"foo"}]`), "", false, userjwt)
+		if code != http.StatusBadRequest {
+			t.Error(code)
+		}
+	})
+
+	t.Run("unknown id", func(t *testing.T) {
+		_, _, code := c.PatchImportType("urn:infai:ses:import-type:unknown", model.MergePatch, []byte(`{"name":"x"}`), "", false, userjwt)
+		if code != http.StatusNotFound && code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestPayload(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:  "payload",
+		Image: "image",
+		Output: model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+			{Name: "station", Type: model.String, UseAsTag: true},
+			{Name: "value", Type: model.Float},
+		}},
+	}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("sample passes check", func(t *testing.T) {
+		sample, err, _ := c.GetImportTypeSamplePayload(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		result, err, _ := c.CheckImportTypePayload(importType.Id, sample, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Valid {
+			t.Errorf("%#v %#v", sample, result)
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		result, err, _ := c.CheckImportTypePayload(importType.Id, map[string]interface{}{"value": "13"}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Valid || len(result.Findings) != 2 || result.Findings[0].Path != "/station" || result.Findings[1].Path != "/value" {
+			t.Errorf("%#v", result)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+func TestImportTypePermissions(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	owner, err := createToken("test", "owner")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	other, err := createToken("test", "other")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:   "permissions",
+		Image:  "image",
+		Output: model.ContentVariable{Name: "output", Type: model.String},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("owner reads permissions", func(t *testing.T) {
+		perms, err, _ := c.GetImportTypePermissions(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !perms.UserPermissions[owner.GetUserId()].Administrate {
+			t.Errorf("%#v", perms)
+		}
+	})
+
+	t.Run("other user may not read permissions", func(t *testing.T) {
+		_, _, code := c.GetImportTypePermissions(importType.Id, other)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+		_, _, code = c.ReadImportType(importType.Id, other)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+
+	t.Run("removing all administrating users is rejected", func(t *testing.T) {
+		_, _, code := c.SetImportTypePermissions(importType.Id, permV2Model.ResourcePermissions{
+			UserPermissions: map[string]permV2Model.PermissionsMap{
+				owner.GetUserId(): {Read: true, Write: true, Execute: true},
+			},
+		}, owner)
+		if code != http.StatusBadRequest {
+			t.Error(code)
+		}
+	})
+
+	t.Run("share read access", func(t *testing.T) {
+		perms, err, _ := c.SetImportTypePermissions(importType.Id, permV2Model.ResourcePermissions{
+			UserPermissions: map[string]permV2Model.PermissionsMap{
+				owner.GetUserId(): {Read: true, Write: true, Execute: true, Administrate: true},
+				other.GetUserId(): {Read: true},
+			},
+		}, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !perms.RolePermissions["admin"].Administrate {
+			t.Errorf("%#v", perms)
+		}
+		_, err, _ = c.ReadImportType(importType.Id, other)
+		if err != nil {
+			t.Error(err)
+		}
+		_, _, code := c.SetImportTypePermissions(importType.Id, perms, other)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+}
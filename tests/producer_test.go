@@ -0,0 +1,178 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib"
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/database"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/docker"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/mocks"
+	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
+)
+
+func TestProducer(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf, err = NewDockerEnv(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	permv2Client, err := permV2.NewTestClient(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	producer := mocks.NewProducer()
+	err = lib.StartWithDependencies(conf, ctx, wg, permv2Client, producer)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	time.Sleep(2 * time.Second)
+
+	it, err := createImportType(conf, model.ImportType{Name: "published", Image: "image", Configs: []model.ImportConfig{
+		{Name: "api_key", Type: model.String, DefaultValue: "secret", Secret: true},
+		{Name: "interval", Type: model.Integer, DefaultValue: float64(10)},
+	}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	it.Name = "published-update"
+	err = updateImportType(conf, it, it.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	testImportTypeDelete(t, conf, it.Id)
+
+	messages := producer.Messages()
+	if len(messages) != 3 {
+		t.Errorf("%#v", messages)
+		return
+	}
+	for i, expected := range []string{"PUT", "PUT", "DELETE"} {
+		if messages[i].Command != expected || messages[i].Id != it.Id || messages[i].Owner != userjwt.GetUserId() {
+			t.Errorf("%v: %#v", i, messages[i])
+		}
+	}
+	if messages[1].ImportType.Name != "published-update" {
+		t.Errorf("%#v", messages[1].ImportType)
+	}
+	for _, message := range messages[:2] {
+		if len(message.ImportType.Configs) != 2 || message.ImportType.Configs[0].DefaultValue != nil || message.ImportType.Configs[1].DefaultValue != float64(10) {
+			t.Errorf("%#v", message.ImportType.Configs)
+		}
+	}
+}
+
+// failingProducer fails every publish
+type failingProducer struct{}
+
+func (this failingProducer) PublishImportType(importType model.ImportType) error {
+	return errors.New("test error")
+}
+
+func (this failingProducer) PublishImportTypeDelete(id string, owner string) error {
+	return errors.New("test error")
+}
+
+func TestProducerFailure(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.DatabaseBackend = database.BackendMemory
+	port, err := docker.GetFreePort()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.ServerPort = strconv.Itoa(port)
+	permv2Client, err := permV2.NewTestClient(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = lib.StartWithDependencies(conf, ctx, wg, permv2Client, failingProducer{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	time.Sleep(2 * time.Second)
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	// changes are persisted before they are published, so a failed publish does not fail the request
+	created, err, _ := c.CreateImportType(model.ImportType{Name: "unpublished", Image: "image"}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	read, err, _ := c.ReadImportType(created.Id, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	read.Description = "updated"
+	updated, err, _ := c.SetImportTypeWithOptions(read, false, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if updated.Description != "updated" {
+		t.Errorf("%#v", updated)
+	}
+	err, _ = c.DeleteImportTypeIfMatch(created.Id, updated.Etag, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, _, code := c.ReadImportType(created.Id, userjwt)
+	if code != http.StatusNotFound && code != http.StatusForbidden {
+		t.Error(code)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestReleases(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	importType, err, _ := c.CreateImportType(model.ImportType{Name: "releases", Image: "import:0.1.0"}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("publish stable", func(t *testing.T) {
+		result, err, _ := c.PublishImportTypeRelease(importType.Id, model.ImportTypeRelease{Version: "1.0.0", Image: "ghcr.io/senergy-platform/import:1.0.0", Changelog: "initial release"}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Image != "ghcr.io/senergy-platform/import:1.0.0" || len(result.Releases) != 1 || result.Releases[0].Channel != model.ReleaseChannelStable || result.Releases[0].PublishedAt.IsZero() {
+			t.Errorf("%#v", result)
+		}
+		current, err, _ := c.ReadImportType(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Etag == "" || result.Etag != current.Etag {
+			t.Error(result.Etag, current.Etag)
+		}
+	})
+
+	t.Run("publish beta keeps stable image", func(t *testing.T) {
+		result, err, _ := c.PublishImportTypeRelease(importType.Id, model.ImportTypeRelease{Version: "1.1.0-beta.1", Image: "ghcr.io/senergy-platform/import:1.1.0-beta.1", Channel: model.ReleaseChannelBeta}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Image != "ghcr.io/senergy-platform/import:1.0.0" {
+			t.Error(result.Image)
+		}
+	})
+
+	t.Run("lower version", func(t *testing.T) {
+		_, err, code := c.PublishImportTypeRelease(importType.Id, model.ImportTypeRelease{Version: "1.0.1", Image: "import:1.0.1"}, userjwt)
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("invalid image", func(t *testing.T) {
+		_, err, code := c.PublishImportTypeRelease(importType.Id, model.ImportTypeRelease{Version: "2.0.0", Image: "Not An Image"}, userjwt)
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("publish next stable", func(t *testing.T) {
+		_, err, _ := c.PublishImportTypeRelease(importType.Id, model.ImportTypeRelease{Version: "1.1.0", Image: "ghcr.io/senergy-platform/import:1.1.0"}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		result, err, _ := c.ReadImportType(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Image != "ghcr.io/senergy-platform/import:1.1.0" {
+			t.Error(result.Image)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		releases, err, _ := c.ListImportTypeReleases(importType.Id, "", userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(releases) != 3 || releases[0].Version != "1.1.0" || releases[2].Version != "1.0.0" {
+			t.Errorf("%#v", releases)
+		}
+		releases, err, _ = c.ListImportTypeReleases(importType.Id, model.ReleaseChannelBeta, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(releases) != 1 || releases[0].Version != "1.1.0-beta.1" {
+			t.Errorf("%#v", releases)
+		}
+		_, err, code := c.ListImportTypeReleases(importType.Id, "nightly", userjwt)
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+}
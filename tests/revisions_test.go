@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestRevisions(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	v1, err := createImportType(conf, model.ImportType{
+		Name:  "v1",
+		Image: "image:1",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	v2 := v1
+	v2.Name = "v2"
+	v2.Image = "image:2"
+	err = updateImportType(conf, v2, v2.Id)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("list", func(t *testing.T) {
+		list, total, err, _ := c.ListImportTypeRevisions(v1.Id, userjwt, model.ImportTypeRevisionListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 2 || len(list) != 2 {
+			t.Error(total, len(list))
+			return
+		}
+		if list[0].Revision != 2 || !reflect.DeepEqual(list[0].ImportType, v2) {
+			t.Errorf("%#v", list[0])
+		}
+		if list[1].Revision != 1 || !reflect.DeepEqual(list[1].ImportType, v1) {
+			t.Errorf("%#v", list[1])
+		}
+		if list[0].Author != userjwt.GetUserId() {
+			t.Error(list[0].Author)
+		}
+	})
+
+	t.Run("read", func(t *testing.T) {
+		rev, err, _ := c.ReadImportTypeRevision(v1.Id, 1, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(rev.ImportType, v1) {
+			t.Errorf("%#v", rev.ImportType)
+		}
+		_, err, code := c.ReadImportTypeRevision(v1.Id, 42, userjwt)
+		if code != http.StatusNotFound {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("read not allowed", func(t *testing.T) {
+		_, err, code := c.ReadImportTypeRevision(v1.Id, 1, userjwt2)
+		if code != http.StatusForbidden {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err, _ := c.RestoreImportTypeRevision(v1.Id, 1, false, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if restored.Etag == "" {
+			t.Error("missing etag")
+		}
+		restored.Etag = ""
+		if !reflect.DeepEqual(restored, v1) {
+			t.Errorf("%#v", restored)
+		}
+		testImportTypeRead(t, conf, v1)
+		list, total, err, _ := c.ListImportTypeRevisions(v1.Id, userjwt, model.ImportTypeRevisionListOptions{Limit: 1})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 3 || len(list) != 1 || list[0].Revision != 3 {
+			t.Error(total, list)
+		}
+	})
+
+	t.Run("restore with breaking changes", func(t *testing.T) {
+		v3 := v1
+		v3.Name = "v3"
+		v3.Output = model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{{Name: "value", Type: model.Float}}}
+		err = updateImportType(conf, v3, v3.Id)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		// revision 3 has no output sub content variables
+		_, err, code := c.RestoreImportTypeRevision(v1.Id, 3, false, userjwt)
+		var breakingErr *model.BreakingChangesError
+		if code != http.StatusConflict || !errors.As(err, &breakingErr) {
+			t.Error(err, code)
+			return
+		}
+		testImportTypeRead(t, conf, v3)
+		_, err, _ = c.RestoreImportTypeRevision(v1.Id, 3, true, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		testImportTypeRead(t, conf, v1)
+	})
+}
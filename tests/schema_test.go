@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestSchema(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:  "schema",
+		Image: "image",
+		Output: model.ContentVariable{Name: "output", Type: model.Structure, SubContentVariables: []model.ContentVariable{
+			{Name: "value", Type: model.Float, CharacteristicId: "urn:infai:ses:characteristic:celsius"},
+		}},
+		Configs: []model.ImportConfig{
+			{Name: "interval", Type: model.Integer, DefaultValue: 10},
+			{Name: "api_key", Type: model.String, Required: true, Secret: true},
+			{Name: "token", Type: model.String, Required: true, Secret: true, DefaultValue: "secret"},
+		},
+	}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("configs", func(t *testing.T) {
+		schema, err, _ := c.GetImportTypeConfigsSchema(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if schema.Type != "object" || schema.Properties["interval"].Type != "integer" || schema.Properties["interval"].Default != float64(10) || !schema.Properties["api_key"].WriteOnly || len(schema.Required) != 1 {
+			t.Errorf("%#v", schema)
+		}
+	})
+
+	t.Run("configs of other users", func(t *testing.T) {
+		// other users do not receive secret default values, but the required configs are the same
+		other, err := createTokenWithRoles("test", "other", []string{"admin"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		schema, err, _ := c.GetImportTypeConfigsSchema(importType.Id, other)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !reflect.DeepEqual(schema.Required, []string{"api_key"}) || schema.Properties["token"].Default != nil {
+			t.Errorf("%#v", schema)
+		}
+	})
+
+	t.Run("output", func(t *testing.T) {
+		schema, err, _ := c.GetImportTypeOutputSchema(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if schema.Type != "object" || schema.Properties["value"].CharacteristicId != "urn:infai:ses:characteristic:celsius" {
+			t.Errorf("%#v", schema)
+		}
+	})
+
+	t.Run("avro", func(t *testing.T) {
+		schema, err, _ := c.GetImportTypeAvroSchema(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		record, ok := schema.(map[string]interface{})
+		if !ok || record["type"] != "record" || record["name"] != "schema" {
+			t.Errorf("%#v", schema)
+		}
+	})
+
+	t.Run("proto", func(t *testing.T) {
+		schema, err, _ := c.GetImportTypeProtoSchema(importType.Id, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !strings.Contains(schema, `double value = 1 [(characteristic_id) = "urn:infai:ses:characteristic:celsius"];`) {
+			t.Error(schema)
+		}
+	})
+
+	t.Run("compatibility", func(t *testing.T) {
+		output := importType.Output
+		output.SubContentVariables = append([]model.ContentVariable{}, output.SubContentVariables...)
+		output.SubContentVariables[0].AspectId = "urn:infai:ses:aspect:air"
+		result, err, _ := c.CheckImportTypeOutputCompatibility(importType.Id, output, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Compatible || len(result.Changes) != 1 {
+			t.Errorf("%#v", result)
+		}
+		// messages of the current output do not contain the new field
+		output.SubContentVariables = append(output.SubContentVariables, model.ContentVariable{Name: "humidity", Type: model.Float})
+		result, err, _ = c.CheckImportTypeOutputCompatibility(importType.Id, output, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Compatible {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("unknown import type", func(t *testing.T) {
+		_, _, code := c.GetImportTypeOutputSchema("urn:infai:ses:import-type:unknown", userjwt)
+		if code != http.StatusNotFound && code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+}
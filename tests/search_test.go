@@ -0,0 +1,104 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestSearch(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	byName, err, _ := c.CreateImportType(model.ImportType{Name: "weather station", Output: model.ContentVariable{Name: "output"}}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	byDescription, err, _ := c.CreateImportType(model.ImportType{Name: "a", Description: "reads the local weather", Output: model.ContentVariable{Name: "output"}}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	byConfig, err, _ := c.CreateImportType(model.ImportType{Name: "b", Configs: []model.ImportConfig{{Name: "weather", Type: model.String, DefaultValue: "sunny"}}, Output: model.ContentVariable{Name: "output"}}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	byOutput, err, _ := c.CreateImportType(model.ImportType{Name: "c", Output: model.ContentVariable{Name: "output", SubContentVariables: []model.ContentVariable{{Name: "weather", Type: model.String}}}}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err, _ = c.CreateImportType(model.ImportType{Name: "d", Description: "unrelated", Output: model.ContentVariable{Name: "output"}}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("text", testImportTypesList(c, client.ImportTypeListOptions{Search: "weather"}, []model.ImportType{byDescription, byConfig, byOutput, byName}))
+
+	t.Run("relevance", func(t *testing.T) {
+		result, total, err, _ := c.ListImportTypes(userjwt, client.ImportTypeListOptions{Search: "weather", SortBy: model.SortByRelevance})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 4 || len(result) != 4 {
+			t.Error(total, len(result))
+			return
+		}
+		if result[0].Id != byName.Id || result[1].Id != byDescription.Id {
+			t.Error(result)
+			return
+		}
+	})
+
+	t.Run("prefix", testImportTypesList(c, client.ImportTypeListOptions{Search: "weath", SearchMode: model.SearchModePrefix}, []model.ImportType{byName}))
+
+	t.Run("relevance without text search", func(t *testing.T) {
+		_, _, err, code := c.ListImportTypes(userjwt, client.ImportTypeListOptions{SortBy: model.SortByRelevance})
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+
+	t.Run("unknown search mode", func(t *testing.T) {
+		_, _, err, code := c.ListImportTypes(userjwt, client.ImportTypeListOptions{Search: "weather", SearchMode: "fuzzy"})
+		if err == nil || code != http.StatusBadRequest {
+			t.Error(err, code)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib"
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/mocks"
+	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
+)
+
+// failingPermV2Client fails SetPermission while fail is set
+type failingPermV2Client struct {
+	permV2.Client
+	fail atomic.Bool
+}
+
+func (this *failingPermV2Client) SetPermission(token string, topicId string, id string, permissions permV2.ResourcePermissions) (result permV2.ResourcePermissions, err error, code int) {
+	if this.fail.Load() {
+		return result, errors.New("test error"), http.StatusInternalServerError
+	}
+	return this.Client.SetPermission(token, topicId, id, permissions)
+}
+
+func TestTransferOwnership(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf, err = NewDockerEnv(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	testClient, err := permV2.NewTestClient(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	permv2Client := &failingPermV2Client{Client: testClient}
+	err = lib.StartWithDependencies(conf, ctx, wg, permv2Client, mocks.NewProducer())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	time.Sleep(2 * time.Second)
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	owner, err := createToken("test", "owner")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	colleague, err := createToken("test", "colleague")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:   "transfer",
+		Image:  "image",
+		Output: model.ContentVariable{Name: "output", Type: model.String},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("colleague may not take ownership", func(t *testing.T) {
+		_, _, code := c.TransferImportTypeOwnership(importType.Id, model.ImportTypeOwnershipTransfer{NewOwner: colleague.GetUserId()}, colleague)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+
+	t.Run("failed permission update is reverted", func(t *testing.T) {
+		permv2Client.fail.Store(true)
+		defer permv2Client.fail.Store(false)
+		_, err, _ := c.TransferImportTypeOwnership(importType.Id, model.ImportTypeOwnershipTransfer{NewOwner: colleague.GetUserId()}, owner)
+		if err == nil {
+			t.Error("expected error")
+			return
+		}
+		stored, err, _ := c.ReadImportType(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if stored.Owner != owner.GetUserId() {
+			t.Errorf("%#v", stored)
+		}
+	})
+
+	t.Run("transfer keeping read access", func(t *testing.T) {
+		result, err, _ := c.TransferImportTypeOwnership(importType.Id, model.ImportTypeOwnershipTransfer{NewOwner: colleague.GetUserId(), KeepReadAccess: true}, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Owner != colleague.GetUserId() {
+			t.Errorf("%#v", result)
+		}
+		perms, err, _ := c.GetImportTypePermissions(importType.Id, colleague)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !perms.UserPermissions[colleague.GetUserId()].Administrate {
+			t.Errorf("%#v", perms)
+		}
+		_, err, _ = c.ReadImportType(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+		}
+		_, _, code := c.GetImportTypePermissions(importType.Id, owner)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SENERGY-Platform/import-repository/lib"
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/config"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+	"github.com/SENERGY-Platform/import-repository/lib/testutils/mocks"
+	permV2 "github.com/SENERGY-Platform/permissions-v2/pkg/client"
+	permV2Model "github.com/SENERGY-Platform/permissions-v2/pkg/model"
+)
+
+func TestTrash(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	owner, err := createToken("test", "owner")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	reader, err := createToken("test", "reader")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	importType, err, _ := c.CreateImportType(model.ImportType{
+		Name:   "trash",
+		Image:  "image",
+		Output: model.ContentVariable{Name: "output", Type: model.String},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	permissions, err, _ := c.SetImportTypePermissions(importType.Id, permV2Model.ResourcePermissions{
+		UserPermissions: map[string]permV2Model.PermissionsMap{
+			owner.GetUserId():  {Read: true, Write: true, Execute: true, Administrate: true},
+			reader.GetUserId(): {Read: true},
+		},
+	}, owner)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		err, _ := c.DeleteImportType(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, err, _ = c.ReadImportType(importType.Id, owner)
+		if err == nil {
+			t.Error("expected error")
+		}
+		list, _, err, _ := c.ListImportTypes(owner, model.ImportTypeListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(list) != 0 {
+			t.Errorf("%#v", list)
+		}
+	})
+
+	t.Run("list trash", func(t *testing.T) {
+		list, total, err, _ := c.ListTrashedImportTypes(owner, model.TrashListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 1 || len(list) != 1 || list[0].ImportType.Id != importType.Id || list[0].DeletedBy != owner.GetUserId() {
+			t.Errorf("%v %#v", total, list)
+		}
+		list, total, err, _ = c.ListTrashedImportTypes(reader, model.TrashListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 0 || len(list) != 0 {
+			t.Errorf("%v %#v", total, list)
+		}
+		list, total, err, _ = c.ListTrashedImportTypes(userjwt, model.TrashListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total != 1 || len(list) != 1 {
+			t.Errorf("admin: %v %#v", total, list)
+		}
+	})
+
+	t.Run("reader may not restore", func(t *testing.T) {
+		_, _, code := c.RestoreTrashedImportType(importType.Id, false, reader)
+		if code != http.StatusForbidden {
+			t.Error(code)
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err, _ := c.RestoreTrashedImportType(importType.Id, false, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if restored.Name != importType.Name || restored.Owner != owner.GetUserId() {
+			t.Errorf("%#v", restored)
+		}
+		current, err, _ := c.ReadImportType(importType.Id, reader)
+		if err != nil {
+			t.Error(err)
+		} else if restored.Etag == "" || restored.Etag != current.Etag {
+			t.Error(restored.Etag, current.Etag)
+		}
+		restoredPermissions, err, _ := c.GetImportTypePermissions(importType.Id, owner)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(restoredPermissions.UserPermissions) != len(permissions.UserPermissions) || !restoredPermissions.UserPermissions[reader.GetUserId()].Read {
+			t.Errorf("%#v", restoredPermissions)
+		}
+		_, _, code := c.RestoreTrashedImportType(importType.Id, false, owner)
+		if code != http.StatusNotFound {
+			t.Error(code)
+		}
+	})
+}
+
+func TestTrashPurge(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conf, err := config.Load("../config.json")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf, err = NewDockerEnv(conf, ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	conf.TrashRetention = "2s"
+	conf.TrashPurgeInterval = "500ms"
+	permv2Client, err := permV2.NewTestClient(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err = lib.StartWithDependencies(conf, ctx, wg, permv2Client, mocks.NewProducer())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	time.Sleep(2 * time.Second)
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	importType, err, _ := c.CreateImportType(model.ImportType{Name: "purge", Image: "image"}, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	err, _ = c.DeleteImportType(importType.Id, userjwt)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, total, err, _ := c.ListTrashedImportTypes(userjwt, model.TrashListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if total != 1 {
+		t.Error(total)
+		return
+	}
+	time.Sleep(4 * time.Second)
+	_, total, err, _ = c.ListTrashedImportTypes(userjwt, model.TrashListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if total != 0 {
+		t.Error(total)
+	}
+	_, _, code := c.RestoreTrashedImportType(importType.Id, false, userjwt)
+	if code != http.StatusNotFound {
+		t.Error(code)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/SENERGY-Platform/import-repository/lib/client"
 	"github.com/SENERGY-Platform/import-repository/lib/config"
 	"github.com/SENERGY-Platform/import-repository/lib/controller"
 	"github.com/SENERGY-Platform/import-repository/lib/log"
@@ -222,6 +223,22 @@ func TestUserDelete(t *testing.T) {
 	t.Run("check user1 after delete", checkUserImportTypes(permv2Client, user1, []string{}, ids))
 	t.Run("check user2 after delete", checkUserImportTypes(permv2Client, user2, users2Expected, ids))
 
+	t.Run("trash entries without deleted user", func(t *testing.T) {
+		c := client.NewClient("http://localhost:" + conf.ServerPort)
+		list, total, err, _ := c.ListTrashedImportTypes(userjwt, model.TrashListOptions{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if total == 0 {
+			t.Error("missing trash entries of deleted user")
+		}
+		for _, trashed := range list {
+			if _, ok := trashed.Permissions.UserPermissions[user1.GetUserId()]; ok {
+				t.Errorf("%#v", trashed.Permissions)
+			}
+		}
+	})
 }
 
 type IdWrapper struct {
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/SENERGY-Platform/import-repository/lib/client"
+	"github.com/SENERGY-Platform/import-repository/lib/log"
+	"github.com/SENERGY-Platform/import-repository/lib/model"
+)
+
+func TestValidateDraft(t *testing.T) {
+	log.InitForTest()
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, conf, err := createTestEnv(ctx, wg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if conf.Validate {
+		t.Error("test expects validation of writes to be disabled")
+		return
+	}
+
+	c := client.NewClient("http://localhost:" + conf.ServerPort)
+
+	t.Run("valid", func(t *testing.T) {
+		result, err, _ := c.ValidateImportTypeDraft(model.ImportType{
+			Name:   "draft",
+			Image:  "image",
+			Output: model.ContentVariable{Name: "output", Type: model.String},
+		}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !result.Valid || len(result.Findings) != 0 {
+			t.Errorf("%#v", result)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		result, err, _ := c.ValidateImportTypeDraft(model.ImportType{
+			Name:   "draft",
+			Output: model.ContentVariable{Name: "output", Type: model.String, SubContentVariables: []model.ContentVariable{{Name: "sub", Type: model.String}}},
+		}, userjwt)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if result.Valid || len(result.Findings) != 2 {
+			t.Errorf("%#v", result)
+			return
+		}
+		if result.Findings[0].Path != "/image" || result.Findings[1].Path != "/output/sub_content_variables" {
+			t.Errorf("%#v", result.Findings)
+		}
+	})
+
+	t.Run("nothing stored", func(t *testing.T) {
+		list, _, err, _ := c.ListImportTypes(userjwt, model.ImportTypeListOptions{Search: "draft"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if len(list) != 0 {
+			t.Errorf("%#v", list)
+		}
+	})
+}